@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// accountSettings maps the keys accepted by "nlm account set" to the
+// settings field they change.
+var accountSettings = map[string]func(s *pb.AccountSettings, value string) error{
+	"output-language": func(s *pb.AccountSettings, value string) error {
+		s.OutputLanguage = wrapperspb.String(value)
+		return nil
+	},
+	"email-notifications": func(s *pb.AccountSettings, value string) error {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("email-notifications must be true or false")
+		}
+		s.EmailNotifications = wrapperspb.Bool(b)
+		return nil
+	},
+}
+
+func accountSettingKeys() string {
+	keys := make([]string, 0, len(accountSettings))
+	for k := range accountSettings {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ", ")
+}
+
+func showAccount(c *api.Client, asJSON bool) error {
+	account, err := c.GetOrCreateAccount()
+	if err != nil {
+		return err
+	}
+	if asJSON {
+		out, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(account)
+		if err != nil {
+			return fmt.Errorf("encode account: %w", err)
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+
+	fmt.Printf("Account:  %s", account.Email)
+	if account.DisplayName != "" {
+		fmt.Printf(" (%s)", account.DisplayName)
+	}
+	fmt.Printf("\nPlan:     %s\n", planString(account.Plan))
+
+	limits := account.GetLimits()
+	fmt.Printf("\nLimits:\n")
+	fmt.Printf("  Notebooks:                %s\n", formatCount(limits.GetMaxNotebooks()))
+	fmt.Printf("  Sources per notebook:     %s\n", formatCount(limits.GetMaxSourcesPerNotebook()))
+	fmt.Printf("  Audio overviews per day:  %s\n", formatCount(limits.GetMaxDailyAudioOverviews()))
+
+	settings := account.GetSettings()
+	fmt.Printf("\nSettings:\n")
+	lang := "-"
+	if v := settings.GetOutputLanguage(); v != nil {
+		lang = v.Value
+	}
+	notifications := "-"
+	if v := settings.GetEmailNotifications(); v != nil {
+		notifications = strconv.FormatBool(v.Value)
+	}
+	fmt.Printf("  output-language:          %s\n", lang)
+	fmt.Printf("  email-notifications:      %s\n", notifications)
+	return nil
+}
+
+func setAccountSetting(c *api.Client, key, value string) error {
+	set, ok := accountSettings[key]
+	if !ok {
+		return fmt.Errorf("unknown setting %q (known settings: %s)", key, accountSettingKeys())
+	}
+	settings := &pb.AccountSettings{}
+	if err := set(settings, value); err != nil {
+		return err
+	}
+	if _, err := c.MutateAccount(settings); err != nil {
+		return fmt.Errorf("set %s: %w", key, err)
+	}
+	fmt.Printf("✅ Set %s to %s\n", key, value)
+	return nil
+}
+
+func planString(p pb.AccountPlan) string {
+	switch p {
+	case pb.AccountPlan_ACCOUNT_PLAN_STANDARD:
+		return "standard"
+	case pb.AccountPlan_ACCOUNT_PLAN_PLUS:
+		return "plus"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+func TestSetAccountSetting(t *testing.T) {
+	tests := []struct {
+		name       string
+		key, value string
+		want       string // f.req args of MutateAccount; empty when nothing is sent
+		wantErr    bool
+	}{
+		{
+			name:  "output language",
+			key:   "output-language",
+			value: "de",
+			want:  `[["de"]]`,
+		},
+		{
+			name:  "email notifications off",
+			key:   "email-notifications",
+			value: "false",
+			want:  `[[null,false]]`,
+		},
+		{
+			name:    "not a bool",
+			key:     "email-notifications",
+			value:   "maybe",
+			wantErr: true,
+		},
+		{
+			name:    "unknown key",
+			key:     "theme",
+			value:   "dark",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mutated string
+			c := newTestClient(t, func(rpcID, freq string) string {
+				if rpcID != pb.NotebookLM_MutateAccount_RPCID {
+					t.Errorf("unexpected RPC %s", rpcID)
+				}
+				mutated = freq
+				return `[]`
+			})
+			err := setAccountSetting(c, tt.key, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("setAccountSetting() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if mutated != "" {
+					t.Errorf("MutateAccount sent for an invalid setting: %s", mutated)
+				}
+				return
+			}
+			if got := rpcArgs(t, mutated); got != tt.want {
+				t.Errorf("MutateAccount args = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type notebookAnalytics struct {
+	NotebookID string               `json:"notebookId"`
+	Title      string               `json:"title,omitempty"`
+	Analytics  *pb.ProjectAnalytics `json:"-"`
+	Raw        json.RawMessage      `json:"analytics,omitempty"`
+	Error      string               `json:"error,omitempty"`
+}
+
+// showAnalytics writes usage analytics for the given notebooks, or for every
+// notebook if none are given, to w. A notebook whose analytics cannot be
+// read is reported with its error and does not stop the report.
+func showAnalytics(c *api.Client, w io.Writer, notebookIDs []string, asJSON bool) error {
+	projects, err := c.ListRecentlyViewedProjects()
+	if err != nil {
+		return fmt.Errorf("list notebooks: %w", err)
+	}
+	titles := make(map[string]string, len(projects))
+	for _, p := range projects {
+		titles[p.ProjectId] = strings.TrimSpace(strings.TrimSpace(p.Emoji) + " " + p.Title)
+	}
+	if len(notebookIDs) == 0 {
+		for _, p := range projects {
+			notebookIDs = append(notebookIDs, p.ProjectId)
+		}
+	}
+
+	var results []notebookAnalytics
+	failed := 0
+	for _, id := range notebookIDs {
+		r := notebookAnalytics{NotebookID: id, Title: titles[id]}
+		if r.Analytics, err = c.GetProjectAnalytics(id); err != nil {
+			r.Error = err.Error()
+			failed++
+		}
+		results = append(results, r)
+	}
+	if err := writeAnalytics(w, results, asJSON); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("analytics: %d of %d notebooks failed", failed, len(results))
+	}
+	return nil
+}
+
+func writeAnalytics(w io.Writer, results []notebookAnalytics, asJSON bool) error {
+	if asJSON {
+		for i := range results {
+			if results[i].Analytics == nil {
+				continue
+			}
+			raw, err := protojson.Marshal(results[i].Analytics)
+			if err != nil {
+				return fmt.Errorf("encode analytics: %w", err)
+			}
+			results[i].Raw = raw
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(results)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 4, ' ', 0)
+	fmt.Fprintln(tw, "ID\tTITLE\tVIEWS\tVIEWERS\tCHATS\tSHARES\tLAST VIEWED")
+	for _, r := range results {
+		if r.Error != "" {
+			fmt.Fprintf(tw, "%s\t%s\terror: %s\n", r.NotebookID, r.Title, r.Error)
+			continue
+		}
+		a := r.Analytics
+		lastViewed := "-"
+		if a.LastViewedTime != nil {
+			lastViewed = formatTimestamp(a.LastViewedTime)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			r.NotebookID, r.Title,
+			formatCount(a.ViewCount),
+			formatCount(a.UniqueViewerCount),
+			formatCount(a.ChatCount),
+			formatCount(a.ShareCount),
+			lastViewed,
+		)
+	}
+	return tw.Flush()
+}
+
+// formatCount renders a count the server may not report as "-".
+func formatCount(v *wrapperspb.Int32Value) string {
+	if v == nil {
+		return "-"
+	}
+	return strconv.Itoa(int(v.Value))
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+func TestShowAnalyticsReportsFailedNotebooks(t *testing.T) {
+	c := newTestClient(t, func(rpcID, freq string) string {
+		if rpcID == pb.NotebookLM_ListRecentlyViewedProjects_RPCID {
+			return `[[["One",null,"nb1"],["Two",null,"nb2"],["Three",null,"nb3"]]]`
+		}
+		if rpcArgs(t, freq) == `["nb2"]` {
+			return `"not analytics"`
+		}
+		return `[[4],[2],[1],[0]]`
+	})
+
+	var out bytes.Buffer
+	err := showAnalytics(c, &out, nil, false)
+	if err == nil || !strings.Contains(err.Error(), "1 of 3 notebooks failed") {
+		t.Errorf("showAnalytics() error = %v, want 1 of 3 failed", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("got %d lines, want header and 3 rows:\n%s", len(lines), out.String())
+	}
+	for i, want := range []string{"nb1    One      4", "nb2    Two      error: ", "nb3    Three    4"} {
+		if !strings.HasPrefix(lines[i+1], want) {
+			t.Errorf("row %d = %q, want prefix %q", i+1, lines[i+1], want)
+		}
+	}
+}
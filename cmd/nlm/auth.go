@@ -3,9 +3,11 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"regexp"
 	"strconv"
@@ -27,13 +29,7 @@ func handleAuth(args []string, debug bool) (string, string, error) {
 		return detectAuthInfo(string(input))
 	}
 
-	profileName := "Default"
-	if v := os.Getenv("NLM_BROWSER_PROFILE"); v != "" {
-		profileName = v
-	}
-	if len(args) > 0 {
-		profileName = args[0]
-	}
+	profileName := browserProfile(args)
 
 	a := auth.New(debug)
 	fmt.Fprintf(os.Stderr, "nlm: launching browser to login... (profile:%v)  (set with NLM_BROWSER_PROFILE)\n", profileName)
@@ -44,6 +40,41 @@ func handleAuth(args []string, debug bool) (string, string, error) {
 	return persistAuthToDisk(cookies, token, profileName)
 }
 
+// handleCapture authenticates through the browser and then appends the web
+// app's batchexecute traffic to path until the browser is closed or the
+// command is interrupted.
+func handleCapture(args []string, path string, debug bool) (string, string, error) {
+	profileName := browserProfile(args)
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return "", "", fmt.Errorf("open capture file: %w", err)
+	}
+	defer f.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	a := auth.New(debug)
+	fmt.Fprintf(os.Stderr, "nlm: launching browser to capture traffic to %s... (profile:%v)\n", path, profileName)
+	token, cookies, err := a.GetAuth(auth.WithProfileName(profileName), auth.WithCapture(ctx, f))
+	if err != nil {
+		return "", "", fmt.Errorf("browser auth failed: %w", err)
+	}
+	return persistAuthToDisk(cookies, token, profileName)
+}
+
+func browserProfile(args []string) string {
+	profileName := "Default"
+	if v := os.Getenv("NLM_BROWSER_PROFILE"); v != "" {
+		profileName = v
+	}
+	if len(args) > 0 {
+		profileName = args[0]
+	}
+	return profileName
+}
+
 func readFromStdin() (string, error) {
 	var input strings.Builder
 	buf := make([]byte, 1024)
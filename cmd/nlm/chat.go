@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+)
+
+// askQuestion asks a single question about a notebook, streaming the answer
+// to stdout followed by its citations.
+func askQuestion(c *api.Client, notebookID, question string, sources *sourceSelection, note *saveNoteFlag) error {
+	sourceIDs, err := sources.resolve(c, notebookID)
+	if err != nil {
+		return err
+	}
+	answer, err := ask(c, notebookID, question, &api.AskOptions{SourceIDs: sourceIDs})
+	if err != nil {
+		return err
+	}
+	return note.save(c, notebookID, noteTitle(question), answer.Text, answer.Citations)
+}
+
+// runChat starts an interactive chat about a notebook. Each question is asked
+// with the earlier turns of the conversation as context.
+func runChat(c *api.Client, notebookID string, sources *sourceSelection) error {
+	sourceIDs, err := sources.resolve(c, notebookID)
+	if err != nil {
+		return err
+	}
+	// The sources are fetched once for the session rather than by every
+	// question.
+	p, err := c.GetProject(notebookID)
+	if err != nil {
+		return fmt.Errorf("get notebook: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Chatting with notebook %s. Type /save [title] to keep the last answer as a note,\n", notebookID)
+	fmt.Fprintf(os.Stderr, "/new to start over, /exit or Ctrl-D to quit.\n")
+	opts := &api.AskOptions{SourceIDs: sourceIDs, Sources: p.Sources}
+	var lastQuestion string
+	var lastAnswer *api.Answer
+	scanner := bufio.NewScanner(os.Stdin)
+	for {
+		fmt.Print("\n> ")
+		if !scanner.Scan() {
+			fmt.Println()
+			return scanner.Err()
+		}
+		question := strings.TrimSpace(scanner.Text())
+		switch cmd, arg, _ := strings.Cut(question, " "); cmd {
+		case "":
+			continue
+		case "/exit", "/quit":
+			return nil
+		case "/new":
+			opts = &api.AskOptions{SourceIDs: sourceIDs, Sources: p.Sources}
+			fmt.Fprintf(os.Stderr, "Started a new conversation\n")
+			continue
+		case "/save":
+			if lastAnswer == nil {
+				fmt.Fprintf(os.Stderr, "Nothing to save yet\n")
+				continue
+			}
+			note := &saveNoteFlag{enabled: true, title: strings.TrimSpace(arg)}
+			if err := note.save(c, notebookID, noteTitle(lastQuestion), lastAnswer.Text, lastAnswer.Citations); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			}
+			continue
+		}
+
+		answer, err := ask(c, notebookID, question, opts)
+		if err != nil {
+			// Keep the session going; the question can be asked again.
+			fmt.Fprintln(os.Stderr, err)
+			continue
+		}
+		lastQuestion, lastAnswer = question, answer
+		opts.ConversationID = answer.ConversationID
+		opts.History = append(opts.History,
+			&pb.ChatTurn{Text: question, Role: pb.ChatRole_CHAT_ROLE_USER},
+			&pb.ChatTurn{Text: answer.Text, Role: pb.ChatRole_CHAT_ROLE_MODEL},
+		)
+	}
+}
+
+func ask(c *api.Client, notebookID, question string, opts *api.AskOptions) (*api.Answer, error) {
+	streamed := false
+	opts.OnText = func(text string) {
+		streamed = true
+		fmt.Print(text)
+	}
+	answer, err := c.Ask(notebookID, question, opts)
+	if err != nil {
+		if streamed {
+			fmt.Println()
+		}
+		return nil, err
+	}
+	if !streamed {
+		fmt.Print(answer.Text)
+	}
+	fmt.Println()
+	printCitations(os.Stdout, answer.Citations)
+	return answer, nil
+}
+
+func printCitations(w io.Writer, citations []api.Citation) {
+	if len(citations) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\nSources:\n")
+	for i, cit := range citations {
+		if cit.Title != "" {
+			fmt.Fprintf(w, "  [%d] %s (%s)\n", i+1, cit.Title, cit.SourceID)
+		} else {
+			fmt.Fprintf(w, "  [%d] %s\n", i+1, cit.SourceID)
+		}
+		if quote := strings.Join(strings.Fields(cit.Quote), " "); quote != "" {
+			fmt.Fprintf(w, "      %q\n", quote)
+		}
+	}
+}
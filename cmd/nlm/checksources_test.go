@@ -0,0 +1,43 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+func TestCheckSourcesChecksOnlyDriveSources(t *testing.T) {
+	var checked []string
+	c := newTestClient(t, func(rpcID, freq string) string {
+		switch rpcID {
+		case pb.NotebookLM_GetProject_RPCID:
+			return `["Notebook",[` +
+				`[["doc"],"Doc",[null,null,null,null,3]],` +
+				`[["slides"],"Slides",[null,null,null,null,4]],` +
+				`[["web"],"Web page",[null,null,null,null,7]],` +
+				`[["untyped"],"Untyped doc",[["1a2b"]]]` +
+				`],"nb1"]`
+		case pb.NotebookLM_CheckSourceFreshness_RPCID:
+			var args []string
+			if err := json.Unmarshal([]byte(rpcArgs(t, freq)), &args); err != nil || len(args) != 1 {
+				t.Fatalf("CheckSourceFreshness args = %s (%v)", rpcArgs(t, freq), err)
+			}
+			checked = append(checked, args[0])
+			if args[0] == "untyped" {
+				return `"not a response"`
+			}
+			return `[true]`
+		}
+		t.Errorf("unexpected RPC %s", rpcID)
+		return `[]`
+	})
+
+	if err := checkSources(c, "nb1"); err == nil {
+		t.Error("checkSources() succeeded, want an error for the source that could not be checked")
+	}
+	if want := []string{"doc", "slides", "untyped"}; !reflect.DeepEqual(checked, want) {
+		t.Errorf("checked %v, want %v", checked, want)
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var conversationalGoals = map[string]pb.ConversationalGoal{
+	"default":        pb.ConversationalGoal_CONVERSATIONAL_GOAL_DEFAULT,
+	"learning-guide": pb.ConversationalGoal_CONVERSATIONAL_GOAL_LEARNING_GUIDE,
+	"custom":         pb.ConversationalGoal_CONVERSATIONAL_GOAL_CUSTOM,
+}
+
+var responseLengths = map[string]pb.ResponseLength{
+	"default": pb.ResponseLength_RESPONSE_LENGTH_DEFAULT,
+	"longer":  pb.ResponseLength_RESPONSE_LENGTH_LONGER,
+	"shorter": pb.ResponseLength_RESPONSE_LENGTH_SHORTER,
+}
+
+// chatConfigFlags are the flags of "nlm configure". Empty values leave the
+// corresponding setting unchanged.
+type chatConfigFlags struct {
+	goal       string
+	prompt     string
+	promptFile string // "-" reads the prompt from stdin
+	length     string
+	language   string
+
+	// unverified allows writing the settings, whose positions in Project
+	// are not confirmed by a capture. A wrong position would overwrite some
+	// other notebook field.
+	unverified bool
+}
+
+func (f chatConfigFlags) empty() bool {
+	return f == chatConfigFlags{unverified: f.unverified}
+}
+
+// configureNotebook changes the chat configuration of a notebook, or prints
+// it if no flags are set.
+func configureNotebook(c *api.Client, notebookID string, flags chatConfigFlags) error {
+	p, err := c.GetProject(notebookID)
+	if err != nil {
+		return fmt.Errorf("get notebook: %w", err)
+	}
+	if flags.empty() {
+		printChatConfig(p)
+		return nil
+	}
+	if !flags.unverified {
+		return fmt.Errorf("changing chat settings writes notebook fields at positions not yet confirmed by captured traffic, " +
+			"so it could overwrite other notebook settings; pass --unverified-layout to accept that risk")
+	}
+
+	// A changed message is sent whole so the settings in it that are not
+	// changed keep their current values. Unchanged messages are not sent.
+	config := &pb.ChatbotConfig{}
+	if p.Config != nil {
+		config = proto.Clone(p.Config).(*pb.ChatbotConfig)
+	}
+	advanced := &pb.AdvancedSettings{}
+	if p.AdvancedSettings != nil {
+		advanced = proto.Clone(p.AdvancedSettings).(*pb.AdvancedSettings)
+	}
+	updates := &pb.Project{}
+
+	if flags.prompt != "" && flags.promptFile != "" {
+		return fmt.Errorf("use either --prompt or --prompt-file")
+	}
+	prompt := flags.prompt
+	if flags.promptFile != "" {
+		if prompt, err = readPromptFile(flags.promptFile); err != nil {
+			return err
+		}
+	}
+	if prompt != "" {
+		if flags.goal == "" {
+			flags.goal = "custom"
+		}
+		config.CustomPrompt = prompt
+		updates.Config = config
+	}
+	if flags.goal != "" {
+		goal, ok := conversationalGoals[flags.goal]
+		if !ok {
+			return fmt.Errorf("invalid goal %q: must be default, learning-guide or custom", flags.goal)
+		}
+		if goal == pb.ConversationalGoal_CONVERSATIONAL_GOAL_CUSTOM && config.CustomPrompt == "" {
+			return fmt.Errorf("--goal custom needs --prompt or --prompt-file")
+		}
+		if goal != pb.ConversationalGoal_CONVERSATIONAL_GOAL_CUSTOM && prompt != "" {
+			return fmt.Errorf("a custom prompt needs --goal custom")
+		}
+		config.Goal = goal
+		updates.Config = config
+	}
+	if flags.length != "" {
+		length, ok := responseLengths[flags.length]
+		if !ok {
+			return fmt.Errorf("invalid length %q: must be default, longer or shorter", flags.length)
+		}
+		config.ResponseLength = length
+		updates.Config = config
+	}
+	if flags.language != "" {
+		advanced.OutputLanguage = wrapperspb.String(flags.language)
+		updates.AdvancedSettings = advanced
+	}
+
+	updated, err := c.MutateProject(notebookID, updates)
+	if err != nil {
+		return fmt.Errorf("configure notebook: %w", err)
+	}
+	fmt.Printf("✅ Updated chat configuration\n")
+	if updated.GetConfig() == nil {
+		// Not every response echoes the notebook back.
+		updated = &pb.Project{Config: config, AdvancedSettings: advanced}
+	}
+	printChatConfig(updated)
+	return nil
+}
+
+func readPromptFile(path string) (string, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("read prompt: %w", err)
+	}
+	prompt := strings.TrimSpace(string(data))
+	if prompt == "" {
+		return "", fmt.Errorf("prompt file %s is empty", path)
+	}
+	return prompt, nil
+}
+
+func printChatConfig(p *pb.Project) {
+	config := p.GetConfig()
+	fmt.Printf("Goal:      %s\n", enumName(conversationalGoals, config.GetGoal()))
+	fmt.Printf("Length:    %s\n", enumName(responseLengths, config.GetResponseLength()))
+	lang := "-"
+	if v := p.GetAdvancedSettings().GetOutputLanguage(); v != nil {
+		lang = v.Value
+	}
+	fmt.Printf("Language:  %s\n", lang)
+	if prompt := config.GetCustomPrompt(); prompt != "" {
+		fmt.Printf("\nCustom prompt:\n%s\n", prompt)
+	}
+}
+
+// enumName returns the flag value of v, or "default" if it is unset.
+func enumName[E comparable](names map[string]E, v E) string {
+	for name, e := range names {
+		if e == v {
+			return name
+		}
+	}
+	return "default"
+}
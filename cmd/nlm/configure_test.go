@@ -0,0 +1,60 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+func TestConfigureNotebookSendsOnlyChangedSettings(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags chatConfigFlags
+		want  string // f.req args of MutateProject
+	}{
+		{
+			name:  "language only",
+			flags: chatConfigFlags{language: "de", unverified: true},
+			want:  `["nb1",[null,null,null,null,null,null,null,["de"]]]`,
+		},
+		{
+			name:  "length keeps the custom prompt",
+			flags: chatConfigFlags{length: "shorter", unverified: true},
+			want:  `["nb1",[null,null,null,null,null,null,[3,"Answer as a tutor.",3]]]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mutated string
+			c := newTestClient(t, func(rpcID, freq string) string {
+				if rpcID == pb.NotebookLM_MutateProject_RPCID {
+					mutated = freq
+					return `[]`
+				}
+				return `["Notebook",[],"nb1","",null,null,[3,"Answer as a tutor.",2],["en"]]`
+			})
+			if err := configureNotebook(c, "nb1", tt.flags); err != nil {
+				t.Fatalf("configureNotebook() error = %v", err)
+			}
+			if got := rpcArgs(t, mutated); got != tt.want {
+				t.Errorf("MutateProject args = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigureNotebookNeedsUnverifiedLayout(t *testing.T) {
+	c := newTestClient(t, func(rpcID, freq string) string {
+		if rpcID == pb.NotebookLM_MutateProject_RPCID {
+			t.Error("MutateProject sent without --unverified-layout")
+		}
+		return `["Notebook",[],"nb1"]`
+	})
+	if err := configureNotebook(c, "nb1", chatConfigFlags{language: "de"}); err == nil {
+		t.Error("configureNotebook() succeeded, want error")
+	}
+	// Showing the settings needs no flag.
+	if err := configureNotebook(c, "nb1", chatConfigFlags{}); err != nil {
+		t.Errorf("configureNotebook() without changes error = %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	runtimedebug "runtime/debug"
+	"strings"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+)
+
+func submitFeedback(c *api.Client, message, notebookID string, includeDebug bool) error {
+	var diagnostics *pb.FeedbackContext
+	if includeDebug {
+		diagnostics = feedbackDiagnostics(c, notebookID)
+		fmt.Fprintf(os.Stderr, "Including diagnostics:\n%s\n%s\n", diagnostics.Client, diagnostics.DebugInfo)
+	}
+	if err := c.SubmitFeedback(notebookID, message, diagnostics); err != nil {
+		return err
+	}
+	fmt.Printf("✅ Feedback submitted\n")
+	return nil
+}
+
+// feedbackDiagnostics describes the client and, if notebookID is set, the
+// notebook the feedback is about. It never includes source content.
+func feedbackDiagnostics(c *api.Client, notebookID string) *pb.FeedbackContext {
+	version := "(devel)"
+	if info, ok := runtimedebug.ReadBuildInfo(); ok && info.Main.Version != "" {
+		version = info.Main.Version
+	}
+	fc := &pb.FeedbackContext{
+		Client: fmt.Sprintf("nlm/%s (%s/%s; %s)", version, runtime.GOOS, runtime.GOARCH, runtime.Version()),
+	}
+	if notebookID == "" {
+		return fc
+	}
+
+	p, err := c.GetProject(notebookID)
+	if err != nil {
+		fc.DebugInfo = fmt.Sprintf("notebook %s: %v", notebookID, err)
+		return fc
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "notebook %s: %d sources\n", notebookID, len(p.Sources))
+	for _, src := range p.Sources {
+		fmt.Fprintf(&b, "  %s %s %s\n",
+			src.SourceId.GetSourceId(),
+			src.Metadata.GetSourceType(),
+			src.GetSettings().GetStatus(),
+		)
+	}
+	fc.DebugInfo = strings.TrimSuffix(b.String(), "\n")
+	return fc
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+func TestFeedbackDiagnostics(t *testing.T) {
+	c := newTestClient(t, func(rpcID, freq string) string {
+		if rpcID != pb.NotebookLM_GetProject_RPCID {
+			t.Errorf("unexpected RPC %s", rpcID)
+		}
+		return `["Quarterly Plan",[` +
+			`[["src1"],"Salary Review.pdf",[null,null,null,null,3],[null,1]],` +
+			`[["src2"],"Layoff Memo",[null,null,null,null,5],[null,3]]` +
+			`],"nb1"]`
+	})
+
+	fc := feedbackDiagnostics(c, "nb1")
+	if !strings.HasPrefix(fc.Client, "nlm/") {
+		t.Errorf("Client = %q, want an nlm/ version string", fc.Client)
+	}
+	for _, want := range []string{"notebook nb1: 2 sources", "src1", "src2"} {
+		if !strings.Contains(fc.DebugInfo, want) {
+			t.Errorf("DebugInfo = %q, want it to contain %q", fc.DebugInfo, want)
+		}
+	}
+	for _, content := range []string{"Quarterly Plan", "Salary Review", "Layoff Memo"} {
+		if strings.Contains(fc.DebugInfo, content) || strings.Contains(fc.Client, content) {
+			t.Errorf("diagnostics include notebook content %q:\n%s\n%s", content, fc.Client, fc.DebugInfo)
+		}
+	}
+}
+
+func TestFeedbackDiagnosticsWithoutNotebook(t *testing.T) {
+	c := newTestClient(t, func(rpcID, freq string) string {
+		t.Errorf("unexpected RPC %s", rpcID)
+		return `[]`
+	})
+	if fc := feedbackDiagnostics(c, ""); fc.DebugInfo != "" {
+		t.Errorf("DebugInfo = %q, want empty", fc.DebugInfo)
+	}
+}
+
+func TestFeedbackDiagnosticsNotebookError(t *testing.T) {
+	c := newTestClient(t, func(rpcID, freq string) string {
+		return `"not a notebook"`
+	})
+	fc := feedbackDiagnostics(c, "nb1")
+	if !strings.HasPrefix(fc.DebugInfo, "notebook nb1: ") {
+		t.Errorf("DebugInfo = %q, want the GetProject error", fc.DebugInfo)
+	}
+}
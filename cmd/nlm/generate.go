@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+)
+
+type artifactKind struct {
+	title    string // default title, also used for the note
+	generate func(c *api.Client, projectID string, sourceIDs ...string) (*pb.GenerateArtifactResponse, error)
+}
+
+// artifactKinds maps the types accepted by "nlm generate" to their generator.
+var artifactKinds = map[string]artifactKind{
+	"faq":         {"FAQ", (*api.Client).GenerateFAQ},
+	"study-guide": {"Study guide", (*api.Client).GenerateStudyGuide},
+	"briefing":    {"Briefing doc", (*api.Client).GenerateBriefingDoc},
+	"timeline":    {"Timeline", (*api.Client).GenerateTimeline},
+}
+
+func artifactKindNames() string {
+	names := make([]string, 0, len(artifactKinds))
+	for name := range artifactKinds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, "|")
+}
+
+// generateArtifact generates a study document from a notebook and writes it
+// as Markdown.
+func generateArtifact(c *api.Client, kind, notebookID string, sources *sourceSelection, note *saveNoteFlag, output string) error {
+	k, ok := artifactKinds[kind]
+	if !ok {
+		return fmt.Errorf("unknown type %q: must be one of %s", kind, artifactKindNames())
+	}
+	sourceIDs, err := sources.resolve(c, notebookID)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Generating %s...\n", strings.ToLower(k.title))
+	artifact, err := k.generate(c, notebookID, sourceIDs...)
+	if err != nil {
+		return fmt.Errorf("generate %s: %w", kind, err)
+	}
+	title := artifact.Title
+	if title == "" {
+		title = k.title
+	}
+	content := strings.TrimSpace(artifact.Content)
+
+	if err := writeOutput(output, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "# %s\n\n%s\n", title, content)
+		return err
+	}); err != nil {
+		return err
+	}
+	return note.save(c, notebookID, title, content, nil)
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+func TestGenerateArtifactSourceSelection(t *testing.T) {
+	tests := []struct {
+		name    string
+		sources sourceSelection
+		want    string // f.req args of GenerateArtifact
+	}{
+		{
+			name: "every source by default",
+			want: `["nb1",1]`,
+		},
+		{
+			name:    "listed sources",
+			sources: sourceSelection{ids: "src3, src1"},
+			want:    `["nb1",1,[[["src3"]],[["src1"]]]]`,
+		},
+		{
+			name:    "matching sources",
+			sources: sourceSelection{pattern: "meeting*"},
+			want:    `["nb1",1,[[["src1"]],[["src2"]]]]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var generated string
+			fetched := false
+			c := newTestClient(t, func(rpcID, freq string) string {
+				switch rpcID {
+				case pb.NotebookLM_GenerateArtifact_RPCID:
+					generated = freq
+					return `["FAQ","Q: Why?"]`
+				case pb.NotebookLM_GetProject_RPCID:
+					fetched = true
+					return `["Notebook",[[["src1"],"Meeting Notes"],[["src2"],"meeting agenda"],[["src3"],"Budget"]],"nb1"]`
+				}
+				t.Errorf("unexpected RPC %s", rpcID)
+				return `[]`
+			})
+			output := filepath.Join(t.TempDir(), "faq.md")
+			if err := generateArtifact(c, "faq", "nb1", &tt.sources, &saveNoteFlag{}, output); err != nil {
+				t.Fatalf("generateArtifact() error = %v", err)
+			}
+			if got := rpcArgs(t, generated); got != tt.want {
+				t.Errorf("GenerateArtifact args = %s, want %s", got, tt.want)
+			}
+			if tt.sources == (sourceSelection{}) && fetched {
+				t.Error("GetProject called without a source selection")
+			}
+		})
+	}
+}
+
+func TestGenerateArtifactUnknownSource(t *testing.T) {
+	c := newTestClient(t, func(rpcID, freq string) string {
+		if rpcID == pb.NotebookLM_GenerateArtifact_RPCID {
+			t.Error("GenerateArtifact sent with an unknown source")
+		}
+		return `["Notebook",[[["src1"],"Meeting Notes"]],"nb1"]`
+	})
+	sources := &sourceSelection{ids: "src9"}
+	if err := generateArtifact(c, "faq", "nb1", sources, &saveNoteFlag{}, ""); err == nil {
+		t.Error("generateArtifact() succeeded, want error")
+	}
+}
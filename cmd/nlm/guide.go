@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+)
+
+// showGuide prints the summary and suggested questions of a notebook, or
+// only the questions if questionsOnly is set. If askN is positive, suggested
+// question askN is then asked in chat.
+func showGuide(c *api.Client, notebookID string, sources *sourceSelection, note *saveNoteFlag, questionsOnly bool, askN int) error {
+	if askN < 0 {
+		return fmt.Errorf("invalid --ask %d: must be the number of a suggested question", askN)
+	}
+	sourceIDs, err := sources.resolve(c, notebookID)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Generating notebook guide...\n")
+	guide, err := c.GenerateNotebookGuide(notebookID, sourceIDs...)
+	if err != nil {
+		return fmt.Errorf("generate guide: %w", err)
+	}
+	questions := guide.SuggestedQuestions
+	if askN > len(questions) {
+		return fmt.Errorf("no suggested question %d: the guide has %d", askN, len(questions))
+	}
+
+	if !questionsOnly {
+		summary := guide.Summary
+		if summary == "" {
+			summary = guide.Content
+		}
+		fmt.Printf("%s\n", strings.TrimSpace(summary))
+		if len(questions) > 0 {
+			fmt.Printf("\nSuggested questions:\n")
+		}
+	}
+	for i, q := range questions {
+		fmt.Printf("%d. %s\n", i+1, q.Question)
+	}
+	if err := note.save(c, notebookID, "Notebook guide", guideMarkdown(guide), nil); err != nil {
+		return err
+	}
+
+	if askN <= 0 {
+		return nil
+	}
+	q := questions[askN-1]
+	prompt := q.Prompt
+	if prompt == "" {
+		prompt = q.Question
+	}
+	fmt.Printf("\n> %s\n\n", q.Question)
+	_, err = ask(c, notebookID, prompt, &api.AskOptions{SourceIDs: sourceIDs})
+	return err
+}
+
+// guideMarkdown renders a guide for saving as a note.
+func guideMarkdown(guide *pb.GenerateNotebookGuideResponse) string {
+	var b strings.Builder
+	summary := guide.Summary
+	if summary == "" {
+		summary = guide.Content
+	}
+	b.WriteString(strings.TrimSpace(summary))
+	b.WriteString("\n")
+	if len(guide.SuggestedQuestions) > 0 {
+		b.WriteString("\nSuggested questions:\n\n")
+		for i, q := range guide.SuggestedQuestions {
+			fmt.Fprintf(&b, "%d. %s\n", i+1, q.Question)
+		}
+	}
+	return b.String()
+}
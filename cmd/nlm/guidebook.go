@@ -0,0 +1,172 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+)
+
+const guidebookUsage = `usage: nlm guidebook <command> [arguments]
+
+  list                          List guidebooks
+  show <guidebook-id>           Show guidebook details
+  publish <notebook-id> [--title T]  Publish a notebook as a guidebook
+  share <guidebook-id> [--public|--private]  Share a guidebook
+  rm <guidebook-id>             Delete a guidebook
+  ask <guidebook-id> <question> Ask a question of a guidebook`
+
+func runGuidebook(c *api.Client, args []string) error {
+	if len(args) < 1 {
+		log.Fatal(guidebookUsage)
+	}
+	fs := flag.NewFlagSet("guidebook "+args[0], flag.ExitOnError)
+	title := fs.String("title", "", "guidebook title (default: the notebook title)")
+	public := fs.Bool("public", false, "let anyone with the link view the guidebook")
+	private := fs.Bool("private", false, "restrict access to collaborators")
+	cmd, args := args[0], parseFlags(fs, args[1:])
+
+	switch {
+	case cmd == "list" && len(args) == 0:
+		return listGuidebooks(c)
+	case cmd == "show" && len(args) == 1:
+		return showGuidebook(c, args[0])
+	case cmd == "publish" && len(args) == 1:
+		return publishGuidebook(c, args[0], *title)
+	case cmd == "share" && len(args) == 1:
+		access, err := shareAccess(*public, *private)
+		if err != nil {
+			return err
+		}
+		return shareGuidebook(c, args[0], access)
+	case cmd == "rm" && len(args) == 1:
+		return removeGuidebook(c, args[0])
+	case cmd == "ask" && len(args) == 2:
+		return askGuidebook(c, args[0], args[1])
+	}
+	log.Fatal(guidebookUsage)
+	return nil
+}
+
+func listGuidebooks(c *api.Client) error {
+	guidebooks, err := c.ListRecentlyViewedGuidebooks()
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 4, ' ', 0)
+	fmt.Fprintln(w, "ID\tTITLE\tSTATUS\tPUBLISHED")
+	for _, gb := range guidebooks {
+		published := "-"
+		if gb.PublishTime != nil {
+			published = formatTimestamp(gb.PublishTime)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			gb.GuidebookId, strings.TrimSpace(gb.Title), guidebookStatusString(gb.Status), published)
+	}
+	return w.Flush()
+}
+
+func showGuidebook(c *api.Client, guidebookID string) error {
+	details, err := c.GetGuidebookDetails(guidebookID)
+	if err != nil {
+		return fmt.Errorf("show guidebook: %w", err)
+	}
+	gb := details.Guidebook
+	fmt.Printf("Guidebook: %s\n", strings.TrimSpace(gb.GetTitle()))
+	fmt.Printf("Notebook:  %s\n", gb.GetProjectId())
+	fmt.Printf("Status:    %s\n", guidebookStatusString(gb.GetStatus()))
+	if gb.GetPublishTime() != nil {
+		fmt.Printf("Published: %s\n", formatTimestamp(gb.GetPublishTime()))
+	}
+	fmt.Printf("Access:    %s\n", accessString(details.Access))
+	if gb.GetShareUrl() != "" {
+		fmt.Printf("Share URL: %s\n", gb.GetShareUrl())
+	}
+	fmt.Printf("Views:     %s\n", formatCount(details.ViewCount))
+	if len(details.Collaborators) > 0 {
+		fmt.Println("\nCollaborators:")
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 4, ' ', 0)
+		for _, collab := range details.Collaborators {
+			fmt.Fprintf(w, "  %s\t%s\n", collab.Email, roleString(collab.Role))
+		}
+		return w.Flush()
+	}
+	return nil
+}
+
+func publishGuidebook(c *api.Client, notebookID, title string) error {
+	fmt.Fprintf(os.Stderr, "Publishing notebook %s...\n", notebookID)
+	gb, err := c.PublishGuidebook(notebookID, title)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✅ Published guidebook %s: %s\n", gb.GuidebookId, strings.TrimSpace(gb.Title))
+	if gb.ShareUrl != "" {
+		fmt.Printf("Share URL: %s\n", gb.ShareUrl)
+	}
+	return nil
+}
+
+// shareAccess returns the access level selected by the --public and
+// --private flags; with neither, the guidebook's access is left unchanged.
+func shareAccess(public, private bool) (pb.ProjectAccess, error) {
+	switch {
+	case public && private:
+		return 0, fmt.Errorf("--public and --private cannot be used together")
+	case public:
+		return pb.ProjectAccess_PROJECT_ACCESS_ANYONE_WITH_LINK, nil
+	case private:
+		return pb.ProjectAccess_PROJECT_ACCESS_RESTRICTED, nil
+	}
+	return pb.ProjectAccess_PROJECT_ACCESS_UNSPECIFIED, nil
+}
+
+func shareGuidebook(c *api.Client, guidebookID string, access pb.ProjectAccess) error {
+	fmt.Fprintf(os.Stderr, "Generating share link...\n")
+	shareURL, err := c.ShareGuidebook(guidebookID, access)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Share URL: %s\n", shareURL)
+	return nil
+}
+
+func removeGuidebook(c *api.Client, guidebookID string) error {
+	fmt.Printf("Are you sure you want to delete guidebook %s? [y/N] ", guidebookID)
+	var response string
+	fmt.Scanln(&response)
+	if !strings.HasPrefix(strings.ToLower(response), "y") {
+		return fmt.Errorf("operation cancelled")
+	}
+	if err := c.DeleteGuidebook(guidebookID); err != nil {
+		return err
+	}
+	fmt.Printf("✅ Deleted guidebook %s\n", guidebookID)
+	return nil
+}
+
+func askGuidebook(c *api.Client, guidebookID, question string) error {
+	fmt.Fprintf(os.Stderr, "Generating answer...\n")
+	answer, err := c.GuidebookGenerateAnswer(guidebookID, question)
+	if err != nil {
+		return err
+	}
+	fmt.Println(answer)
+	return nil
+}
+
+func guidebookStatusString(s pb.GuidebookStatus) string {
+	switch s {
+	case pb.GuidebookStatus_GUIDEBOOK_STATUS_DRAFT:
+		return "draft"
+	case pb.GuidebookStatus_GUIDEBOOK_STATUS_PUBLISHED:
+		return "published"
+	default:
+		return "-"
+	}
+}
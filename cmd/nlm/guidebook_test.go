@@ -0,0 +1,42 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+func TestShareAccess(t *testing.T) {
+	tests := []struct {
+		name            string
+		public, private bool
+		want            pb.ProjectAccess
+		wantErr         bool
+	}{
+		{name: "neither keeps the current access", want: pb.ProjectAccess_PROJECT_ACCESS_UNSPECIFIED},
+		{name: "public", public: true, want: pb.ProjectAccess_PROJECT_ACCESS_ANYONE_WITH_LINK},
+		{name: "private", private: true, want: pb.ProjectAccess_PROJECT_ACCESS_RESTRICTED},
+		{name: "both", public: true, private: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := shareAccess(tt.public, tt.private)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("shareAccess() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("shareAccess() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunGuidebookShareRejectsConflictingFlags(t *testing.T) {
+	c := newTestClient(t, func(rpcID, freq string) string {
+		t.Errorf("unexpected RPC %s", rpcID)
+		return `[]`
+	})
+	if err := runGuidebook(c, []string{"share", "gb1", "--public", "--private"}); err == nil {
+		t.Error("runGuidebook() succeeded, want error")
+	}
+}
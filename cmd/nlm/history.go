@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+)
+
+type historyMessage struct {
+	Role           string            `json:"role"`
+	Text           string            `json:"text"`
+	Time           *time.Time        `json:"time,omitempty"`
+	ConversationID string            `json:"conversationId,omitempty"`
+	Citations      []historyCitation `json:"citations,omitempty"`
+}
+
+type historyCitation struct {
+	SourceID string `json:"sourceId"`
+	Title    string `json:"title,omitempty"`
+	Quote    string `json:"quote,omitempty"`
+}
+
+// exportHistory writes the chat history of a notebook as markdown or JSON
+// and, if clear is set, then deletes it from the server.
+func exportHistory(c *api.Client, notebookID, format, output string, clear bool) error {
+	if format != "md" && format != "json" {
+		return fmt.Errorf("invalid format %q: must be md or json", format)
+	}
+	p, err := c.GetProject(notebookID)
+	if err != nil {
+		return fmt.Errorf("get notebook: %w", err)
+	}
+	messages, err := c.GetChatHistory(notebookID)
+	if err != nil {
+		return fmt.Errorf("get history: %w", err)
+	}
+
+	titles := make(map[string]string, len(p.Sources))
+	for _, src := range p.Sources {
+		titles[src.SourceId.GetSourceId()] = strings.TrimSpace(src.Title)
+	}
+	history := make([]historyMessage, 0, len(messages))
+	for _, m := range messages {
+		hm := historyMessage{
+			Role:           "user",
+			Text:           m.Text,
+			ConversationID: m.ConversationId,
+		}
+		if m.Role == pb.ChatRole_CHAT_ROLE_MODEL {
+			hm.Role = "model"
+		}
+		if m.CreateTime != nil {
+			t := m.CreateTime.AsTime()
+			hm.Time = &t
+		}
+		for _, cit := range m.Citations {
+			id := cit.SourceId.GetSourceId()
+			hm.Citations = append(hm.Citations, historyCitation{SourceID: id, Title: titles[id], Quote: cit.Quote})
+		}
+		history = append(history, hm)
+	}
+
+	if len(history) == 0 {
+		fmt.Fprintf(os.Stderr, "No chat history for this notebook\n")
+	} else {
+		title := strings.TrimSpace(strings.TrimSpace(p.Emoji) + " " + p.Title)
+		if err := writeOutput(output, func(w io.Writer) error {
+			if format == "json" {
+				return writeJSON(w, history)
+			}
+			writeHistoryMarkdown(w, title, history)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	if !clear {
+		return nil
+	}
+	fmt.Fprintf(os.Stderr, "Are you sure you want to clear the chat history of %s? [y/N] ", notebookID)
+	var response string
+	fmt.Scanln(&response)
+	if !strings.HasPrefix(strings.ToLower(response), "y") {
+		return fmt.Errorf("operation cancelled")
+	}
+	if err := c.DeleteChatHistory(notebookID); err != nil {
+		return fmt.Errorf("clear history: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "✅ Cleared chat history\n")
+	return nil
+}
+
+func writeHistoryMarkdown(w io.Writer, title string, history []historyMessage) {
+	fmt.Fprintf(w, "# Chat history: %s\n", title)
+	for _, m := range history {
+		heading := "Answer"
+		if m.Role == "user" {
+			heading = "Question"
+		}
+		if m.Time != nil {
+			heading += " (" + m.Time.Format(time.RFC3339) + ")"
+		}
+		fmt.Fprintf(w, "\n## %s\n\n%s\n", heading, strings.TrimSpace(m.Text))
+		if len(m.Citations) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\nSources:\n\n")
+		for i, cit := range m.Citations {
+			name := cit.SourceID
+			if cit.Title != "" {
+				name = fmt.Sprintf("%s (%s)", cit.Title, cit.SourceID)
+			}
+			fmt.Fprintf(w, "%d. %s", i+1, name)
+			if quote := strings.Join(strings.Fields(cit.Quote), " "); quote != "" {
+				fmt.Fprintf(w, ": %q", quote)
+			}
+			fmt.Fprintln(w)
+		}
+	}
+}
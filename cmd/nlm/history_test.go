@@ -0,0 +1,83 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+func TestExportHistory(t *testing.T) {
+	c := newTestClient(t, func(rpcID, freq string) string {
+		if rpcID == pb.NotebookLM_GetChatHistory_RPCID {
+			return `[[` +
+				`["m1",1,"What is X?",null,[1700000000,0],"conv1"],` +
+				`["m2",2,"X is Y.",[[["src1"],"Y is\n X"],[["gone"],null]],[1700000060,0],"conv1"]` +
+				`]]`
+		}
+		return `["Research",[[["src1"],"Paper"]],"nb1","📚"]`
+	})
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"md", `# Chat history: 📚 Research
+
+## Question (2023-11-14T22:13:20Z)
+
+What is X?
+
+## Answer (2023-11-14T22:14:20Z)
+
+X is Y.
+
+Sources:
+
+1. Paper (src1): "Y is X"
+2. gone
+`},
+		{"json", `[
+  {
+    "role": "user",
+    "text": "What is X?",
+    "time": "2023-11-14T22:13:20Z",
+    "conversationId": "conv1"
+  },
+  {
+    "role": "model",
+    "text": "X is Y.",
+    "time": "2023-11-14T22:14:20Z",
+    "conversationId": "conv1",
+    "citations": [
+      {
+        "sourceId": "src1",
+        "title": "Paper",
+        "quote": "Y is\n X"
+      },
+      {
+        "sourceId": "gone"
+      }
+    ]
+  }
+]
+`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			output := filepath.Join(t.TempDir(), "history."+tt.format)
+			if err := exportHistory(c, "nb1", tt.format, output, false); err != nil {
+				t.Fatalf("exportHistory() error = %v", err)
+			}
+			got, err := os.ReadFile(output)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, string(got)); diff != "" {
+				t.Errorf("%s export mismatch (-want +got):\n%s", tt.format, diff)
+			}
+		})
+	}
+}
@@ -1,11 +1,15 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"os/signal"
+	"sort"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -13,6 +17,8 @@ import (
 	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
 	"github.com/zbigniew-malinowski/nlm/internal/api"
 	"github.com/zbigniew-malinowski/nlm/internal/batchexecute"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Global flags
@@ -35,17 +41,27 @@ func main() {
 		fmt.Fprintf(os.Stderr, "Usage: nlm <command> [arguments]\n\n")
 		fmt.Fprintf(os.Stderr, "Notebook Commands:\n")
 		fmt.Fprintf(os.Stderr, "  list, ls          List all notebooks\n")
-		fmt.Fprintf(os.Stderr, "  create <title>    Create a new notebook\n")
+		fmt.Fprintf(os.Stderr, "  create <title> [--emoji E]  Create a new notebook\n")
+		fmt.Fprintf(os.Stderr, "  rename <id> <title>  Rename a notebook\n")
+		fmt.Fprintf(os.Stderr, "  emoji <id> <emoji>  Change a notebook's emoji\n")
+		fmt.Fprintf(os.Stderr, "  star|unstar <id>  Star or unstar a notebook\n")
+		fmt.Fprintf(os.Stderr, "  configure <id> [--unverified-layout --goal G ...]  Show or change chat settings\n")
 		fmt.Fprintf(os.Stderr, "  rm <id>           Delete a notebook\n")
-		fmt.Fprintf(os.Stderr, "  analytics <id>    Show notebook analytics\n\n")
+		fmt.Fprintf(os.Stderr, "  analytics [id...] [--json]  Show notebook analytics\n\n")
 
 		fmt.Fprintf(os.Stderr, "Source Commands:\n")
 		fmt.Fprintf(os.Stderr, "  sources <id>      List sources in notebook\n")
 		fmt.Fprintf(os.Stderr, "  add <id> <input>  Add source to notebook\n")
 		fmt.Fprintf(os.Stderr, "  rm-source <id> <source-id>  Remove source\n")
 		fmt.Fprintf(os.Stderr, "  rename-source <source-id> <new-name>  Rename source\n")
+		fmt.Fprintf(os.Stderr, "  source-show <source-id> [--output file]  Print the text extracted from a source\n")
+		fmt.Fprintf(os.Stderr, "  source-guide <id> [source-id] [--output file]  Print source summaries and key topics\n")
+		fmt.Fprintf(os.Stderr, "  source-enable <id> <source-id...> [--only]  Use sources in chat and generation\n")
+		fmt.Fprintf(os.Stderr, "  source-disable <id> <source-id...>  Exclude sources from chat and generation\n")
 		fmt.Fprintf(os.Stderr, "  refresh-source <source-id>  Refresh source content\n")
-		fmt.Fprintf(os.Stderr, "  check-source <source-id>  Check source freshness\n\n")
+		fmt.Fprintf(os.Stderr, "  refresh --all [--notebook <id>] [--only-stale]  Refresh Google Docs/Slides sources\n")
+		fmt.Fprintf(os.Stderr, "  check-source <source-id>  Check source freshness\n")
+		fmt.Fprintf(os.Stderr, "  check-sources <id>  List stale Google Docs/Slides sources\n\n")
 
 		fmt.Fprintf(os.Stderr, "Note Commands:\n")
 		fmt.Fprintf(os.Stderr, "  notes <id>        List notes in notebook\n")
@@ -59,16 +75,37 @@ func main() {
 		fmt.Fprintf(os.Stderr, "  audio-rm <id>     Delete audio overview\n")
 		fmt.Fprintf(os.Stderr, "  audio-share <id>  Share audio overview\n\n")
 
+		fmt.Fprintf(os.Stderr, "Chat Commands:\n")
+		fmt.Fprintf(os.Stderr, "  ask <id> <question>  Ask a question about a notebook's sources\n")
+		fmt.Fprintf(os.Stderr, "  chat <id>         Chat interactively with a notebook\n")
+		fmt.Fprintf(os.Stderr, "  history <id> [--format md|json] [--clear]  Export or clear chat history\n\n")
+
 		fmt.Fprintf(os.Stderr, "Generation Commands:\n")
+		fmt.Fprintf(os.Stderr, "  guide <id> [--questions] [--ask n]  Show summary and suggested questions\n")
+		fmt.Fprintf(os.Stderr, "  generate <faq|study-guide|briefing|timeline> <id> [--output file]  Generate a study document\n")
+		fmt.Fprintf(os.Stderr, "  mindmap <id> [--format mermaid|dot|opml|json]  Generate a mind map of the topics\n")
+		fmt.Fprintf(os.Stderr, "  flashcards <id> [--format anki-csv|json|md]  Generate flashcards\n")
+		fmt.Fprintf(os.Stderr, "  quiz <id> [--interactive]  Generate a multiple-choice quiz, or take it\n")
 		fmt.Fprintf(os.Stderr, "  generate-guide <id>  Generate notebook guide\n")
 		fmt.Fprintf(os.Stderr, "  generate-outline <id>  Generate content outline\n")
-		fmt.Fprintf(os.Stderr, "  generate-section <id>  Generate new section\n\n")
+		fmt.Fprintf(os.Stderr, "  generate-section <id>  Generate new section\n")
+		fmt.Fprintf(os.Stderr, "  (ask, chat and the generation commands accept --sources id1,id2\n")
+		fmt.Fprintf(os.Stderr, "   or --sources-matching <glob> to use only some sources)\n")
+		fmt.Fprintf(os.Stderr, "  (ask and text generation commands accept --save-note, or --save-note=title with the =, to keep the output as a note)\n\n")
 
 		fmt.Fprintf(os.Stderr, "Other Commands:\n")
 		fmt.Fprintf(os.Stderr, "  auth [profile]    Setup authentication\n")
-		fmt.Fprintf(os.Stderr, "  share <id>        Share notebook\n")
-		fmt.Fprintf(os.Stderr, "  feedback <msg>    Submit feedback\n")
-		fmt.Fprintf(os.Stderr, "  hb                Send heartbeat\n\n")
+		fmt.Fprintf(os.Stderr, "  account [--json]  Show account, plan limits and settings\n")
+		fmt.Fprintf(os.Stderr, "  account set <key> <value>  Change an account setting\n")
+		fmt.Fprintf(os.Stderr, "  capture [profile] Record web app RPC traffic (auth --capture)\n")
+		fmt.Fprintf(os.Stderr, "  share <id> [--public|--private]  Share notebook\n")
+		fmt.Fprintf(os.Stderr, "  share-info <id>   Show notebook sharing details\n")
+		fmt.Fprintf(os.Stderr, "  collaborators list|add|rm|set-role <id> ...  Manage notebook collaborators\n")
+		fmt.Fprintf(os.Stderr, "  guidebook list|show|publish|share|rm|ask ...  Manage guidebooks\n")
+		fmt.Fprintf(os.Stderr, "  feedback <msg> [--notebook ID] [--include-debug]  Submit feedback\n")
+		fmt.Fprintf(os.Stderr, "  hb [--every 5m]   Send heartbeat (repeatedly with --every)\n")
+		fmt.Fprintf(os.Stderr, "  rpc list          List known RPC endpoints\n")
+		fmt.Fprintf(os.Stderr, "  rpc <id|name> [json-args]  Send a raw RPC call\n\n")
 	}
 
 	if err := run(); err != nil {
@@ -124,10 +161,42 @@ func runCmd(client *api.Client, cmd string, args ...string) error {
 	case "list", "ls":
 		err = list(client)
 	case "create":
+		fs := flag.NewFlagSet("create", flag.ExitOnError)
+		emoji := fs.String("emoji", defaultEmoji, "notebook emoji")
+		args = parseFlags(fs, args)
+		if len(args) != 1 {
+			log.Fatal("usage: nlm create <title> [--emoji E]")
+		}
+		err = create(client, args[0], *emoji)
+	case "configure":
+		fs := flag.NewFlagSet("configure", flag.ExitOnError)
+		var flags chatConfigFlags
+		fs.StringVar(&flags.goal, "goal", "", "conversational goal: default, learning-guide or custom")
+		fs.StringVar(&flags.prompt, "prompt", "", "custom prompt (implies --goal custom)")
+		fs.StringVar(&flags.promptFile, "prompt-file", "", "read the custom prompt from this file, or - for stdin")
+		fs.StringVar(&flags.length, "length", "", "response length: default, longer or shorter")
+		fs.StringVar(&flags.language, "language", "", "answer language, e.g. de")
+		fs.BoolVar(&flags.unverified, "unverified-layout", false, "allow changes, although the positions of the chat settings are not confirmed")
+		args = parseFlags(fs, args)
 		if len(args) != 1 {
-			log.Fatal("usage: nlm create <title>")
+			log.Fatal("usage: nlm configure <notebook-id> [--unverified-layout [--goal G] [--prompt P|--prompt-file F] [--length L] [--language code]]")
 		}
-		err = create(client, args[0])
+		err = configureNotebook(client, args[0], flags)
+	case "rename":
+		if len(args) != 2 {
+			log.Fatal("usage: nlm rename <id> <title>")
+		}
+		err = renameNotebook(client, args[0], args[1])
+	case "emoji":
+		if len(args) != 2 {
+			log.Fatal("usage: nlm emoji <id> <emoji>")
+		}
+		err = setNotebookEmoji(client, args[0], args[1])
+	case "star", "unstar":
+		if len(args) != 1 {
+			log.Fatalf("usage: nlm %s <id>", cmd)
+		}
+		err = starNotebook(client, args[0], cmd == "star")
 	case "rm":
 		if len(args) != 1 {
 			log.Fatal("usage: nlm rm <id>")
@@ -157,6 +226,59 @@ func runCmd(client *api.Client, cmd string, args ...string) error {
 			log.Fatal("usage: nlm rename-source <source-id> <new-name>")
 		}
 		err = renameSource(client, args[0], args[1])
+	case "refresh-source":
+		if len(args) != 1 {
+			log.Fatal("usage: nlm refresh-source <source-id>")
+		}
+		err = refreshSource(client, args[0])
+	case "refresh":
+		fs := flag.NewFlagSet("refresh", flag.ExitOnError)
+		all := fs.Bool("all", false, "refresh sources in every notebook")
+		notebookID := fs.String("notebook", "", "only refresh sources in this notebook")
+		onlyStale := fs.Bool("only-stale", false, "skip sources that are already up to date")
+		jobs := fs.Int("jobs", defaultRefreshJobs, "number of sources to refresh concurrently")
+		if len(parseFlags(fs, args)) != 0 || (!*all && *notebookID == "") {
+			log.Fatal("usage: nlm refresh --all [--notebook <id>] [--only-stale] [--jobs N]")
+		}
+		err = refreshSources(client, *notebookID, *onlyStale, *jobs)
+	case "source-enable", "source-disable":
+		fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+		only := fs.Bool("only", false, "disable every other source in the notebook")
+		args = parseFlags(fs, args)
+		if len(args) < 2 || (*only && cmd != "source-enable") {
+			log.Fatal("usage: nlm source-enable <notebook-id> <source-id...> [--only] | nlm source-disable <notebook-id> <source-id...>")
+		}
+		err = setSourcesEnabled(client, args[0], args[1:], cmd == "source-enable", *only)
+	case "source-show":
+		fs := flag.NewFlagSet("source-show", flag.ExitOnError)
+		output := fs.String("output", "", "write to this file instead of stdout")
+		args = parseFlags(fs, args)
+		if len(args) != 1 {
+			log.Fatal("usage: nlm source-show <source-id> [--output file]")
+		}
+		err = showSource(client, args[0], *output)
+	case "source-guide":
+		fs := flag.NewFlagSet("source-guide", flag.ExitOnError)
+		output := fs.String("output", "", "write to this file instead of stdout")
+		args = parseFlags(fs, args)
+		if len(args) < 1 || len(args) > 2 {
+			log.Fatal("usage: nlm source-guide <notebook-id> [source-id] [--output file]")
+		}
+		var sourceID string
+		if len(args) == 2 {
+			sourceID = args[1]
+		}
+		err = showSourceGuides(client, args[0], sourceID, *output)
+	case "check-source":
+		if len(args) != 1 {
+			log.Fatal("usage: nlm check-source <source-id>")
+		}
+		err = checkSourceFreshness(client, args[0])
+	case "check-sources":
+		if len(args) != 1 {
+			log.Fatal("usage: nlm check-sources <notebook-id>")
+		}
+		err = checkSources(client, args[0])
 
 	// Note operations
 	case "new-note":
@@ -177,10 +299,13 @@ func runCmd(client *api.Client, cmd string, args ...string) error {
 
 		// Audio operations
 	case "audio-create":
+		fs := flag.NewFlagSet("audio-create", flag.ExitOnError)
+		sources := addSourceFlags(fs)
+		args = parseFlags(fs, args)
 		if len(args) != 2 {
-			log.Fatal("usage: nlm audio-create <notebook-id> <instructions>")
+			log.Fatal("usage: nlm audio-create <notebook-id> <instructions> [--sources ids|--sources-matching glob]")
 		}
-		err = createAudioOverview(client, args[0], args[1])
+		err = createAudioOverview(client, args[0], args[1], sources)
 	case "audio-get":
 		if len(args) != 1 {
 			log.Fatal("usage: nlm audio-get <notebook-id>")
@@ -197,44 +322,235 @@ func runCmd(client *api.Client, cmd string, args ...string) error {
 		}
 		err = shareAudioOverview(client, args[0])
 
+		// Chat operations
+	case "ask":
+		fs := flag.NewFlagSet("ask", flag.ExitOnError)
+		sources := addSourceFlags(fs)
+		note := addSaveNoteFlag(fs)
+		args = parseFlags(fs, args)
+		if len(args) < 2 {
+			log.Fatal("usage: nlm ask <notebook-id> <question> [--sources ids|--sources-matching glob] [--save-note[=title]]")
+		}
+		err = askQuestion(client, args[0], strings.Join(args[1:], " "), sources, note)
+	case "chat":
+		fs := flag.NewFlagSet("chat", flag.ExitOnError)
+		sources := addSourceFlags(fs)
+		args = parseFlags(fs, args)
+		if len(args) != 1 {
+			log.Fatal("usage: nlm chat <notebook-id> [--sources ids|--sources-matching glob]")
+		}
+		err = runChat(client, args[0], sources)
+
+	case "history":
+		fs := flag.NewFlagSet("history", flag.ExitOnError)
+		format := fs.String("format", "md", "output format: md or json")
+		output := fs.String("output", "", "write to this file instead of stdout")
+		clear := fs.Bool("clear", false, "clear the history after exporting it")
+		args = parseFlags(fs, args)
+		if len(args) != 1 {
+			log.Fatal("usage: nlm history <notebook-id> [--format md|json] [--output file] [--clear]")
+		}
+		err = exportHistory(client, args[0], *format, *output, *clear)
+
 		// Generation operations
+	case "guide":
+		fs := flag.NewFlagSet("guide", flag.ExitOnError)
+		sources := addSourceFlags(fs)
+		note := addSaveNoteFlag(fs)
+		questions := fs.Bool("questions", false, "list only the suggested questions")
+		askN := fs.Int("ask", 0, "ask suggested question `n` in chat")
+		args = parseFlags(fs, args)
+		if len(args) != 1 {
+			log.Fatal("usage: nlm guide <notebook-id> [--questions] [--ask n] [--sources ids|--sources-matching glob] [--save-note[=title]]")
+		}
+		err = showGuide(client, args[0], sources, note, *questions, *askN)
+	case "generate":
+		fs := flag.NewFlagSet("generate", flag.ExitOnError)
+		sources := addSourceFlags(fs)
+		note := addSaveNoteFlag(fs)
+		output := fs.String("output", "", "write to this file instead of stdout")
+		args = parseFlags(fs, args)
+		if len(args) != 2 {
+			log.Fatalf("usage: nlm generate <%s> <notebook-id> [--output file] [--sources ids|--sources-matching glob] [--save-note[=title]]", artifactKindNames())
+		}
+		err = generateArtifact(client, args[0], args[1], sources, note, *output)
+	case "mindmap":
+		fs := flag.NewFlagSet("mindmap", flag.ExitOnError)
+		sources := addSourceFlags(fs)
+		format := fs.String("format", "mermaid", "output format: mermaid, dot, opml or json")
+		output := fs.String("output", "", "write to this file instead of stdout")
+		args = parseFlags(fs, args)
+		if len(args) != 1 {
+			log.Fatal("usage: nlm mindmap <notebook-id> [--format mermaid|dot|opml|json] [--output file] [--sources ids|--sources-matching glob]")
+		}
+		err = showMindMap(client, args[0], sources, *format, *output)
+	case "flashcards":
+		fs := flag.NewFlagSet("flashcards", flag.ExitOnError)
+		sources := addSourceFlags(fs)
+		note := addSaveNoteFlag(fs)
+		format := fs.String("format", "md", "output format: anki-csv, json or md")
+		output := fs.String("output", "", "write to this file instead of stdout")
+		args = parseFlags(fs, args)
+		if len(args) != 1 {
+			log.Fatal("usage: nlm flashcards <notebook-id> [--format anki-csv|json|md] [--output file] [--sources ids|--sources-matching glob] [--save-note[=title]]")
+		}
+		err = showFlashcards(client, args[0], sources, note, *format, *output)
+	case "quiz":
+		fs := flag.NewFlagSet("quiz", flag.ExitOnError)
+		sources := addSourceFlags(fs)
+		note := addSaveNoteFlag(fs)
+		interactive := fs.Bool("interactive", false, "take the quiz in the terminal and get a score")
+		format := fs.String("format", "md", "output format: md or json")
+		output := fs.String("output", "", "write to this file instead of stdout")
+		args = parseFlags(fs, args)
+		if len(args) != 1 {
+			log.Fatal("usage: nlm quiz <notebook-id> [--interactive | --format md|json [--output file] [--save-note[=title]]] [--sources ids|--sources-matching glob]")
+		}
+		if *interactive {
+			var conflicting []string
+			fs.Visit(func(f *flag.Flag) {
+				if f.Name == "format" || f.Name == "output" || f.Name == "save-note" {
+					conflicting = append(conflicting, "--"+f.Name)
+				}
+			})
+			if len(conflicting) > 0 {
+				log.Fatalf("quiz: --interactive cannot be combined with %s", strings.Join(conflicting, ", "))
+			}
+			err = runQuiz(client, args[0], sources)
+		} else {
+			err = showQuiz(client, args[0], sources, note, *format, *output)
+		}
 	case "generate-guide":
+		fs := flag.NewFlagSet("generate-guide", flag.ExitOnError)
+		sources := addSourceFlags(fs)
+		note := addSaveNoteFlag(fs)
+		args = parseFlags(fs, args)
 		if len(args) != 1 {
-			log.Fatal("usage: nlm generate-guide <notebook-id>")
+			log.Fatal("usage: nlm generate-guide <notebook-id> [--sources ids|--sources-matching glob] [--save-note[=title]]")
 		}
-		err = generateNotebookGuide(client, args[0])
+		err = generateNotebookGuide(client, args[0], sources, note)
 	case "generate-outline":
+		fs := flag.NewFlagSet("generate-outline", flag.ExitOnError)
+		sources := addSourceFlags(fs)
+		note := addSaveNoteFlag(fs)
+		args = parseFlags(fs, args)
 		if len(args) != 1 {
-			log.Fatal("usage: nlm generate-outline <notebook-id>")
+			log.Fatal("usage: nlm generate-outline <notebook-id> [--sources ids|--sources-matching glob] [--save-note[=title]]")
 		}
-		err = generateOutline(client, args[0])
+		err = generateOutline(client, args[0], sources, note)
 	case "generate-section":
+		fs := flag.NewFlagSet("generate-section", flag.ExitOnError)
+		sources := addSourceFlags(fs)
+		note := addSaveNoteFlag(fs)
+		args = parseFlags(fs, args)
 		if len(args) != 1 {
-			log.Fatal("usage: nlm generate-section <notebook-id>")
+			log.Fatal("usage: nlm generate-section <notebook-id> [--sources ids|--sources-matching glob] [--save-note[=title]]")
 		}
-		err = generateSection(client, args[0])
+		err = generateSection(client, args[0], sources, note)
 
 	// Other operations
-	// case "analytics":
-	// 	if len(args) != 1 {
-	// 		log.Fatal("usage: nlm analytics <notebook-id>")
-	// 	}
-	// 	err = getAnalytics(client, args[0])
-	// case "share":
-	// 	if len(args) != 1 {
-	// 		log.Fatal("usage: nlm share <notebook-id>")
-	// 	}
-	// 	err = shareNotebook(client, args[0])
-	// case "feedback":
-	// 	if len(args) != 1 {
-	// 		log.Fatal("usage: nlm feedback <message>")
-	// 	}
-	// 	err = submitFeedback(client, args[0])
-	case "auth":
-		_, _, err = handleAuth(args, debug)
+	case "analytics":
+		fs := flag.NewFlagSet("analytics", flag.ExitOnError)
+		asJSON := fs.Bool("json", false, "print analytics as JSON")
+		err = showAnalytics(client, os.Stdout, parseFlags(fs, args), *asJSON)
+	case "share":
+		fs := flag.NewFlagSet("share", flag.ExitOnError)
+		public := fs.Bool("public", false, "let anyone with the link view the notebook")
+		private := fs.Bool("private", false, "restrict access to collaborators")
+		args = parseFlags(fs, args)
+		if len(args) != 1 || (*public && *private) {
+			log.Fatal("usage: nlm share <notebook-id> [--public|--private]")
+		}
+		access := pb.ProjectAccess_PROJECT_ACCESS_UNSPECIFIED
+		if *public {
+			access = pb.ProjectAccess_PROJECT_ACCESS_ANYONE_WITH_LINK
+		} else if *private {
+			access = pb.ProjectAccess_PROJECT_ACCESS_RESTRICTED
+		}
+		err = shareNotebook(client, args[0], access)
+	case "collaborators":
+		fs := flag.NewFlagSet("collaborators", flag.ExitOnError)
+		role := fs.String("role", "viewer", "role for added collaborators: viewer or editor")
+		args = parseFlags(fs, args)
+		const usage = "usage: nlm collaborators list <notebook-id> | add <notebook-id> <email> [--role viewer|editor] | rm <notebook-id> <email> | set-role <notebook-id> <email> <viewer|editor>"
+		if len(args) < 2 {
+			log.Fatal(usage)
+		}
+		switch sub := args[0]; {
+		case sub == "list" && len(args) == 2:
+			err = listCollaborators(client, args[1])
+		case sub == "add" && len(args) == 3:
+			err = addCollaborator(client, args[1], args[2], *role)
+		case sub == "rm" && len(args) == 3:
+			err = removeCollaborator(client, args[1], args[2])
+		case sub == "set-role" && len(args) == 4:
+			err = setCollaboratorRole(client, args[1], args[2], args[3])
+		default:
+			log.Fatal(usage)
+		}
+	case "share-info":
+		if len(args) != 1 {
+			log.Fatal("usage: nlm share-info <notebook-id>")
+		}
+		err = showShareInfo(client, args[0])
+	case "feedback":
+		fs := flag.NewFlagSet("feedback", flag.ExitOnError)
+		notebookID := fs.String("notebook", "", "notebook the feedback is about")
+		includeDebug := fs.Bool("include-debug", false, "attach client and notebook diagnostics")
+		args = parseFlags(fs, args)
+		if len(args) != 1 {
+			log.Fatal("usage: nlm feedback <message> [--notebook ID] [--include-debug]")
+		}
+		err = submitFeedback(client, args[0], *notebookID, *includeDebug)
+	case "account":
+		fs := flag.NewFlagSet("account", flag.ExitOnError)
+		asJSON := fs.Bool("json", false, "print the account as JSON")
+		args = parseFlags(fs, args)
+		switch {
+		case len(args) == 0:
+			err = showAccount(client, *asJSON)
+		case len(args) == 3 && args[0] == "set":
+			err = setAccountSetting(client, args[1], args[2])
+		default:
+			log.Fatalf("usage: nlm account [--json] | nlm account set <key> <value>\nsettings: %s", accountSettingKeys())
+		}
+	case "guidebook":
+		err = runGuidebook(client, args)
+	case "auth", "capture":
+		fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+		capture := fs.Bool("capture", cmd == "capture", "record batchexecute traffic while the browser is open")
+		captureFile := fs.String("capture-file", "requests.jsonl", "file to append captured traffic to")
+		args = parseFlags(fs, args)
+		if *capture {
+			_, _, err = handleCapture(args, *captureFile, debug)
+		} else {
+			_, _, err = handleAuth(args, debug)
+		}
 
 	case "hb":
-		err = heartbeat(client)
+		fs := flag.NewFlagSet("hb", flag.ExitOnError)
+		every := fs.Duration("every", 0, "keep sending heartbeats at this interval")
+		if len(parseFlags(fs, args)) != 0 {
+			log.Fatal("usage: nlm hb [--every <interval>]")
+		}
+		err = heartbeat(client, *every)
+	case "rpc":
+		fs := flag.NewFlagSet("rpc", flag.ExitOnError)
+		notebookID := fs.String("notebook", "", "notebook ID used as the call's source-path")
+		decode := fs.String("decode", "", "decode the response as this proto message, or \"auto\"")
+		args = parseFlags(fs, args)
+		if len(args) == 1 && args[0] == "list" {
+			err = listRPCs()
+			break
+		}
+		if len(args) < 1 || len(args) > 2 {
+			log.Fatal("usage: nlm rpc list | nlm rpc <rpc-id|name> ['<json-args>'] [--notebook ID] [--decode TYPE|auto]")
+		}
+		jsonArgs := "[]"
+		if len(args) == 2 {
+			jsonArgs = args[1]
+		}
+		err = callRPC(client, args[0], jsonArgs, *notebookID, *decode)
 	default:
 		flag.Usage()
 		os.Exit(1)
@@ -250,18 +566,25 @@ func list(c *api.Client) error {
 		return err
 	}
 	w := tabwriter.NewWriter(os.Stdout, 0, 4, 4, ' ', 0)
-	fmt.Fprintln(w, "ID\tTITLE\tLAST UPDATED")
+	fmt.Fprintln(w, "ID\tTITLE\tROLE\tLAST UPDATED")
 	for _, nb := range notebooks {
-		fmt.Fprintf(w, "%s\t%s\t%s\n",
-			nb.ProjectId, strings.TrimSpace(nb.Emoji)+" "+nb.Title,
+		title := strings.TrimSpace(nb.Emoji) + " " + nb.Title
+		if nb.GetMetadata().GetIsStarred() {
+			title += " ⭐"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			nb.ProjectId, title,
+			roleString(nb.GetMetadata().GetUserRole()),
 			nb.GetMetadata().GetCreateTime().AsTime().Format(time.RFC3339),
 		)
 	}
 	return w.Flush()
 }
 
-func create(c *api.Client, title string) error {
-	notebook, err := c.CreateProject(title, "📙")
+const defaultEmoji = "📙"
+
+func create(c *api.Client, title, emoji string) error {
+	notebook, err := c.CreateProject(title, emoji)
 	if err != nil {
 		return err
 	}
@@ -269,6 +592,36 @@ func create(c *api.Client, title string) error {
 	return nil
 }
 
+func renameNotebook(c *api.Client, id, title string) error {
+	if _, err := c.MutateProject(id, &pb.Project{Title: title}); err != nil {
+		return fmt.Errorf("rename notebook: %w", err)
+	}
+	fmt.Printf("✅ Renamed notebook to: %s\n", title)
+	return nil
+}
+
+func setNotebookEmoji(c *api.Client, id, emoji string) error {
+	if _, err := c.MutateProject(id, &pb.Project{Emoji: emoji}); err != nil {
+		return fmt.Errorf("set emoji: %w", err)
+	}
+	fmt.Printf("✅ Set notebook emoji to %s\n", emoji)
+	return nil
+}
+
+func starNotebook(c *api.Client, id string, starred bool) error {
+	if _, err := c.MutateProject(id, &pb.Project{
+		Metadata: &pb.ProjectMetadata{IsStarred: proto.Bool(starred)},
+	}); err != nil {
+		return fmt.Errorf("star notebook: %w", err)
+	}
+	if starred {
+		fmt.Printf("✅ Starred notebook %s\n", id)
+	} else {
+		fmt.Printf("✅ Unstarred notebook %s\n", id)
+	}
+	return nil
+}
+
 func remove(c *api.Client, id string) error {
 	fmt.Printf("Are you sure you want to delete notebook %s? [y/N] ", id)
 	var response string
@@ -364,6 +717,72 @@ func renameSource(c *api.Client, sourceID, newName string) error {
 	return nil
 }
 
+// setSourcesEnabled enables or disables sources for chat and generation.
+// With only, every other source in the notebook is disabled. Every ID is
+// checked against the notebook before any source is changed.
+func setSourcesEnabled(c *api.Client, notebookID string, sourceIDs []string, enabled, only bool) error {
+	p, err := c.GetProject(notebookID)
+	if err != nil {
+		return fmt.Errorf("get notebook: %w", err)
+	}
+	listed := make(map[string]bool, len(sourceIDs))
+	for _, id := range sourceIDs {
+		listed[id] = true
+	}
+	var others []string
+	for _, src := range p.Sources {
+		id := src.SourceId.GetSourceId()
+		if listed[id] {
+			delete(listed, id)
+			continue
+		}
+		if only {
+			others = append(others, id)
+		}
+	}
+	if len(listed) > 0 {
+		missing := make([]string, 0, len(listed))
+		for id := range listed {
+			missing = append(missing, id)
+		}
+		sort.Strings(missing)
+		return fmt.Errorf("notebook %s has no source %s", notebookID, strings.Join(missing, ", "))
+	}
+
+	var updated []string
+	update := func(id string, enabled bool) error {
+		if _, err := c.SetSourceEnabled(id, enabled); err != nil {
+			if len(updated) == 0 {
+				return fmt.Errorf("update source %s: %w (no sources were changed)", id, err)
+			}
+			return fmt.Errorf("update source %s: %w (already updated: %s)", id, err, strings.Join(updated, ", "))
+		}
+		updated = append(updated, id)
+		return nil
+	}
+	for _, id := range sourceIDs {
+		if err := update(id, enabled); err != nil {
+			return err
+		}
+	}
+	for _, id := range others {
+		if err := update(id, false); err != nil {
+			return err
+		}
+	}
+
+	if enabled {
+		fmt.Printf("✅ Enabled %d sources", len(sourceIDs))
+	} else {
+		fmt.Printf("✅ Disabled %d sources", len(sourceIDs))
+	}
+	if only {
+		fmt.Printf(" and disabled %d others", len(others))
+	}
+	fmt.Println()
+	return nil
+}
+
 // Note operations
 func createNote(c *api.Client, notebookID, title string) error {
 	fmt.Printf("Creating note in notebook %s...\n", notebookID)
@@ -409,19 +828,86 @@ func refreshSource(c *api.Client, sourceID string) error {
 	return nil
 }
 
-// func checkSourceFreshness(c *api.Client, sourceID string) error {
-// 	fmt.Fprintf(os.Stderr, "Checking source %s...\n", sourceID)
-// 	resp, err := c.CheckSourceFreshness(sourceID)
-// 	if err != nil {
-// 		return fmt.Errorf("check source: %w", err)
-// 	}
-// 	if resp.NeedsRefresh {
-// 		fmt.Printf("Source needs refresh (last updated: %s)\n", resp.LastUpdateTime.AsTime().Format(time.RFC3339))
-// 	} else {
-// 		fmt.Printf("Source is up to date (last updated: %s)\n", resp.LastUpdateTime.AsTime().Format(time.RFC3339))
-// 	}
-// 	return nil
-// }
+func checkSourceFreshness(c *api.Client, sourceID string) error {
+	fmt.Fprintf(os.Stderr, "Checking source %s...\n", sourceID)
+	resp, err := c.CheckSourceFreshness(sourceID)
+	if err != nil {
+		return fmt.Errorf("check source: %w", err)
+	}
+	if resp.NeedsRefresh {
+		fmt.Printf("Source needs refresh (last updated: %s)\n", formatTimestamp(resp.LastUpdateTime))
+	} else {
+		fmt.Printf("Source is up to date (last updated: %s)\n", formatTimestamp(resp.LastUpdateTime))
+	}
+	return nil
+}
+
+// checkSources lists the Google Docs and Slides sources of a notebook that
+// are out of date with their original document.
+func checkSources(c *api.Client, notebookID string) error {
+	p, err := c.GetProject(notebookID)
+	if err != nil {
+		return fmt.Errorf("check sources: %w", err)
+	}
+
+	var stale []*pb.Source
+	var failed int
+	for _, src := range p.Sources {
+		if !isDriveSource(src) {
+			continue
+		}
+		resp, err := c.CheckSourceFreshness(src.SourceId.GetSourceId())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "nlm: check source %s: %v\n", src.SourceId.GetSourceId(), err)
+			failed++
+			continue
+		}
+		if resp.NeedsRefresh {
+			stale = append(stale, src)
+		}
+	}
+
+	if len(stale) == 0 {
+		if failed == 0 {
+			fmt.Println("✅ All Google Docs and Slides sources are up to date")
+		}
+	} else {
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 4, ' ', 0)
+		fmt.Fprintln(w, "ID\tTITLE\tTYPE\tLAST UPDATED")
+		for _, src := range stale {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+				src.SourceId.GetSourceId(),
+				strings.TrimSpace(src.Title),
+				src.Metadata.GetSourceType(),
+				formatTimestamp(src.Metadata.GetLastModifiedTime()),
+			)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("check sources: %d of the sources could not be checked", failed)
+	}
+	return nil
+}
+
+// isDriveSource reports whether src is backed by a Google Docs or Slides
+// document, the only kinds of source that can be refreshed.
+func isDriveSource(src *pb.Source) bool {
+	switch src.GetMetadata().GetSourceType() {
+	case pb.SourceType_SOURCE_TYPE_GOOGLE_DOCS, pb.SourceType_SOURCE_TYPE_GOOGLE_SLIDES:
+		return true
+	}
+	return src.GetMetadata().GetGoogleDocs() != nil
+}
+
+func formatTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return "unknown"
+	}
+	return ts.AsTime().Format(time.RFC3339)
+}
 
 // Note operations
 func listNotes(c *api.Client, notebookID string) error {
@@ -514,60 +1000,58 @@ func shareAudioOverview(c *api.Client, notebookID string) error {
 }
 
 // Generation operations
-func generateNotebookGuide(c *api.Client, notebookID string) error {
+func generateNotebookGuide(c *api.Client, notebookID string, sources *sourceSelection, note *saveNoteFlag) error {
+	sourceIDs, err := sources.resolve(c, notebookID)
+	if err != nil {
+		return err
+	}
 	fmt.Fprintf(os.Stderr, "Generating notebook guide...\n")
-	guide, err := c.GenerateNotebookGuide(notebookID)
+	guide, err := c.GenerateNotebookGuide(notebookID, sourceIDs...)
 	if err != nil {
 		return fmt.Errorf("generate guide: %w", err)
 	}
 	fmt.Printf("Guide:\n%s\n", guide.Content)
-	return nil
+	return note.save(c, notebookID, "Notebook guide", guide.Content, nil)
 }
 
-func generateOutline(c *api.Client, notebookID string) error {
+func generateOutline(c *api.Client, notebookID string, sources *sourceSelection, note *saveNoteFlag) error {
+	sourceIDs, err := sources.resolve(c, notebookID)
+	if err != nil {
+		return err
+	}
 	fmt.Fprintf(os.Stderr, "Generating outline...\n")
-	outline, err := c.GenerateOutline(notebookID)
+	outline, err := c.GenerateOutline(notebookID, sourceIDs...)
 	if err != nil {
 		return fmt.Errorf("generate outline: %w", err)
 	}
 	fmt.Printf("Outline:\n%s\n", outline.Content)
-	return nil
+	return note.save(c, notebookID, "Outline", outline.Content, nil)
 }
 
-func generateSection(c *api.Client, notebookID string) error {
+func generateSection(c *api.Client, notebookID string, sources *sourceSelection, note *saveNoteFlag) error {
+	sourceIDs, err := sources.resolve(c, notebookID)
+	if err != nil {
+		return err
+	}
 	fmt.Fprintf(os.Stderr, "Generating section...\n")
-	section, err := c.GenerateSection(notebookID)
+	section, err := c.GenerateSection(notebookID, sourceIDs...)
 	if err != nil {
 		return fmt.Errorf("generate section: %w", err)
 	}
 	fmt.Printf("Section:\n%s\n", section.Content)
-	return nil
+	return note.save(c, notebookID, "Section", section.Content, nil)
 }
 
-// func shareNotebook(c *api.Client, notebookID string) error {
-// 	fmt.Fprintf(os.Stderr, "Generating share link...\n")
-// 	resp, err := c.ShareProject(notebookID)
-// 	if err != nil {
-// 		return fmt.Errorf("share notebook: %w", err)
-// 	}
-// 	fmt.Printf("Share URL: %s\n", resp.ShareUrl)
-// 	return nil
-// }
-
-// func submitFeedback(c *api.Client, message string) error {
-// 	if err := c.SubmitFeedback(message); err != nil {
-// 		return fmt.Errorf("submit feedback: %w", err)
-// 	}
-// 	fmt.Printf("✅ Feedback submitted\n")
-// 	return nil
-// }
-
 // Other operations
-func createAudioOverview(c *api.Client, projectID string, instructions string) error {
+func createAudioOverview(c *api.Client, projectID string, instructions string, sources *sourceSelection) error {
+	sourceIDs, err := sources.resolve(c, projectID)
+	if err != nil {
+		return err
+	}
 	fmt.Printf("Creating audio overview for notebook %s...\n", projectID)
 	fmt.Printf("Instructions: %s\n", instructions)
 
-	result, err := c.CreateAudioOverview(projectID, instructions)
+	result, err := c.CreateAudioOverview(projectID, instructions, sourceIDs...)
 	if err != nil {
 		return fmt.Errorf("create audio overview: %w", err)
 	}
@@ -599,6 +1083,100 @@ func createAudioOverview(c *api.Client, projectID string, instructions string) e
 	return nil
 }
 
-func heartbeat(c *api.Client) error {
+func heartbeat(c *api.Client, every time.Duration) error {
+	if err := c.Heartbeat(); err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stderr, "✅ Session is alive")
+	if every <= 0 {
+		return nil
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	expired := make(chan error, 1)
+	stop := c.StartKeepalive(ctx, api.Keepalive{
+		Interval:  every,
+		OnExpired: func(err error) { expired <- err },
+		OnError: func(err error) {
+			fmt.Fprintf(os.Stderr, "nlm: %v\n", err)
+		},
+	})
+	defer stop()
+
+	fmt.Fprintf(os.Stderr, "Sending heartbeats every %v (Ctrl-C to stop)...\n", every)
+	select {
+	case err := <-expired:
+		return fmt.Errorf("session expired: %w", err)
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+// parseFlags parses fs from args, allowing flags to appear before, between
+// or after positional arguments, and returns the positional arguments.
+func parseFlags(fs *flag.FlagSet, args []string) []string {
+	positional, err := splitFlags(fs, args)
+	if err != nil {
+		log.Fatalf("%s: %v", fs.Name(), err)
+	}
+	return positional
+}
+
+// splitFlags is parseFlags without exiting on an ambiguous flag: one like
+// --save-note, whose value is optional, directly followed by a positional
+// argument that a user may have meant as its value.
+func splitFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		fs.Parse(args)
+		rest := fs.Args()
+		if len(rest) == 0 {
+			return positional, nil
+		}
+		if consumed := args[:len(args)-len(rest)]; len(consumed) > 0 {
+			if name, ok := optionalValueFlag(fs, consumed[len(consumed)-1]); ok {
+				return nil, fmt.Errorf("--%s is followed by %q, which is not taken as its value; write --%s=VALUE, or put --%s after the arguments",
+					name, rest[0], name, name)
+			}
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+// optionalValueFlag reports whether arg is a flag like --save-note given
+// without its optional value, and returns the flag name.
+func optionalValueFlag(fs *flag.FlagSet, arg string) (string, bool) {
+	if !strings.HasPrefix(arg, "-") || strings.Contains(arg, "=") {
+		return "", false
+	}
+	name := strings.TrimLeft(arg, "-")
+	f := fs.Lookup(name)
+	if f == nil {
+		return "", false
+	}
+	_, ok := f.Value.(*saveNoteFlag)
+	return name, ok
+}
+
+// writeOutput calls write with stdout, or with the file at path if it is set.
+func writeOutput(path string, write func(w io.Writer) error) error {
+	if path == "" {
+		return write(os.Stdout)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("create output: %w", err)
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("write output: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Wrote %s\n", path)
 	return nil
 }
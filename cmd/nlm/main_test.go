@@ -0,0 +1,52 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+	"github.com/zbigniew-malinowski/nlm/internal/batchexecute"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+// newTestClient returns a client whose RPCs are answered by respond, which
+// gets the RPC ID and f.req of each call and returns the response payload.
+func newTestClient(t *testing.T, respond func(rpcID, freq string) string) *api.Client {
+	t.Helper()
+	transport := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("parse form: %v", err)
+		}
+		id := r.URL.Query().Get("rpcids")
+		payload, err := json.Marshal(respond(id, r.PostForm.Get("f.req")))
+		if err != nil {
+			t.Fatalf("encode response: %v", err)
+		}
+		body := fmt.Sprintf(")]}'\n\n[[\"wrb.fr\",%q,%s,null,null,null,\"generic\"]]", id, payload)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Status:     http.StatusText(http.StatusOK),
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    r,
+		}, nil
+	})
+	return api.New("token", "cookies", batchexecute.WithHTTPClient(&http.Client{Transport: transport}))
+}
+
+// rpcArgs returns the JSON arguments of the single call in an f.req.
+func rpcArgs(t *testing.T, freq string) string {
+	t.Helper()
+	var req [][][]interface{}
+	if err := json.Unmarshal([]byte(freq), &req); err != nil || len(req) != 1 || len(req[0]) != 1 || len(req[0][0]) < 2 {
+		t.Fatalf("unexpected f.req %q (%v)", freq, err)
+	}
+	args, _ := req[0][0][1].(string)
+	return args
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+)
+
+// mindMapFormats maps the formats accepted by "nlm mindmap" to their renderer.
+var mindMapFormats = map[string]func(w io.Writer, root *pb.MindMapNode) error{
+	"mermaid": writeMindMapMermaid,
+	"dot":     writeMindMapDot,
+	"opml":    writeMindMapOPML,
+	"json":    writeMindMapJSON,
+}
+
+// showMindMap generates the mind map of a notebook and renders it in format.
+func showMindMap(c *api.Client, notebookID string, sources *sourceSelection, format, output string) error {
+	render, ok := mindMapFormats[format]
+	if !ok {
+		return fmt.Errorf("invalid format %q: must be mermaid, dot, opml or json", format)
+	}
+	sourceIDs, err := sources.resolve(c, notebookID)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Generating mind map...\n")
+	root, err := c.GenerateMindMap(notebookID, sourceIDs...)
+	if err != nil {
+		return fmt.Errorf("generate mind map: %w", err)
+	}
+	return writeOutput(output, func(w io.Writer) error {
+		return render(w, root)
+	})
+}
+
+func writeMindMapMermaid(w io.Writer, root *pb.MindMapNode) error {
+	var b strings.Builder
+	b.WriteString("mindmap\n")
+	n := 0
+	var walk func(node *pb.MindMapNode, depth int)
+	walk = func(node *pb.MindMapNode, depth int) {
+		indent := strings.Repeat("  ", depth+1)
+		if depth == 0 {
+			fmt.Fprintf(&b, "%sroot((%s))\n", indent, mermaidText(node.Label))
+		} else {
+			fmt.Fprintf(&b, "%sn%d[%s]\n", indent, n, mermaidText(node.Label))
+		}
+		n++
+		for _, child := range node.Children {
+			walk(child, depth+1)
+		}
+	}
+	walk(root, 0)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// mermaidText escapes the characters that delimit node shapes or start
+// HTML in Mermaid.
+var mermaidText = strings.NewReplacer(
+	"(", "#40;", ")", "#41;",
+	"[", "#91;", "]", "#93;",
+	"{", "#123;", "}", "#125;",
+	"<", "#60;", ">", "#62;",
+	"\"", "#quot;", "\n", " ",
+).Replace
+
+func writeMindMapDot(w io.Writer, root *pb.MindMapNode) error {
+	var b strings.Builder
+	b.WriteString("digraph mindmap {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=rounded];\n")
+	n := 0
+	var walk func(node *pb.MindMapNode) int
+	walk = func(node *pb.MindMapNode) int {
+		id := n
+		n++
+		fmt.Fprintf(&b, "  n%d [label=%s];\n", id, dotQuote(node.Label))
+		for _, child := range node.Children {
+			fmt.Fprintf(&b, "  n%d -> n%d;\n", id, walk(child))
+		}
+		return id
+	}
+	walk(root)
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// dotQuote returns s as a double-quoted DOT string. Only quotes and
+// backslashes are escaped; DOT does not understand Go escapes like \x01.
+func dotQuote(s string) string {
+	return `"` + dotText(s) + `"`
+}
+
+var dotText = strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace
+
+type opmlDocument struct {
+	XMLName xml.Name      `xml:"opml"`
+	Version string        `xml:"version,attr"`
+	Title   string        `xml:"head>title"`
+	Body    []opmlOutline `xml:"body>outline"`
+}
+
+type opmlOutline struct {
+	Text     string        `xml:"text,attr"`
+	Sources  string        `xml:"sources,attr,omitempty"` // comma-separated source IDs
+	Children []opmlOutline `xml:"outline"`
+}
+
+func writeMindMapOPML(w io.Writer, root *pb.MindMapNode) error {
+	var convert func(node *pb.MindMapNode) opmlOutline
+	convert = func(node *pb.MindMapNode) opmlOutline {
+		o := opmlOutline{
+			Text:    node.Label,
+			Sources: strings.Join(sourceIDStrings(node.SourceIds), ","),
+		}
+		for _, child := range node.Children {
+			o.Children = append(o.Children, convert(child))
+		}
+		return o
+	}
+	doc := opmlDocument{
+		Version: "2.0",
+		Title:   root.Label,
+		Body:    []opmlOutline{convert(root)},
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("encode opml: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+type mindMapJSONNode struct {
+	Label    string             `json:"label"`
+	Sources  []string           `json:"sources,omitempty"`
+	Children []*mindMapJSONNode `json:"children,omitempty"`
+}
+
+func writeMindMapJSON(w io.Writer, root *pb.MindMapNode) error {
+	var convert func(node *pb.MindMapNode) *mindMapJSONNode
+	convert = func(node *pb.MindMapNode) *mindMapJSONNode {
+		n := &mindMapJSONNode{Label: node.Label, Sources: sourceIDStrings(node.SourceIds)}
+		for _, child := range node.Children {
+			n.Children = append(n.Children, convert(child))
+		}
+		return n
+	}
+	return writeJSON(w, convert(root))
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+func TestWriteMindMap(t *testing.T) {
+	root := &pb.MindMapNode{
+		Label: `Plan "A" & <B>`,
+		Children: []*pb.MindMapNode{
+			{Label: "Costs [2024]", SourceIds: []*pb.SourceId{{SourceId: "src1"}, {SourceId: "src2"}}},
+			{Label: `Risks (high) a\b`, Children: []*pb.MindMapNode{
+				{Label: "Line\u2028break"},
+			}},
+		},
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"mermaid", `mindmap
+  root((Plan #quot;A#quot; & #60;B#62;))
+    n1[Costs #91;2024#93;]
+    n2[Risks #40;high#41; a\b]
+      n3[Line` + "\u2028" + `break]
+`},
+		{"dot", `digraph mindmap {
+  rankdir=LR;
+  node [shape=box, style=rounded];
+  n0 [label="Plan \"A\" & <B>"];
+  n1 [label="Costs [2024]"];
+  n0 -> n1;
+  n2 [label="Risks (high) a\\b"];
+  n3 [label="Line` + "\u2028" + `break"];
+  n2 -> n3;
+  n0 -> n2;
+}
+`},
+		{"opml", `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+  <head>
+    <title>Plan &#34;A&#34; &amp; &lt;B&gt;</title>
+  </head>
+  <body>
+    <outline text="Plan &#34;A&#34; &amp; &lt;B&gt;">
+      <outline text="Costs [2024]" sources="src1,src2"></outline>
+      <outline text="Risks (high) a\b">
+        <outline text="Line` + "\u2028" + `break"></outline>
+      </outline>
+    </outline>
+  </body>
+</opml>
+`},
+		{"json", `{
+  "label": "Plan \"A\" & <B>",
+  "children": [
+    {
+      "label": "Costs [2024]",
+      "sources": [
+        "src1",
+        "src2"
+      ]
+    },
+    {
+      "label": "Risks (high) a\\b",
+      "children": [
+        {
+          "label": "Line\u2028break"
+        }
+      ]
+    }
+  ]
+}
+`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := mindMapFormats[tt.format](&b, root); err != nil {
+				t.Fatalf("write %s: %v", tt.format, err)
+			}
+			if diff := cmp.Diff(tt.want, b.String()); diff != "" {
+				t.Errorf("%s output mismatch (-want +got):\n%s", tt.format, diff)
+			}
+		})
+	}
+}
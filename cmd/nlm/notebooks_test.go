@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+)
+
+func TestNotebookUpdates(t *testing.T) {
+	tests := []struct {
+		name   string
+		update func(c *api.Client) error
+		want   string // f.req args of MutateProject
+	}{
+		{
+			name:   "rename",
+			update: func(c *api.Client) error { return renameNotebook(c, "nb1", "Renamed") },
+			want:   `["nb1",["Renamed"]]`,
+		},
+		{
+			name:   "emoji",
+			update: func(c *api.Client) error { return setNotebookEmoji(c, "nb1", "🧪") },
+			want:   `["nb1",[null,null,null,"🧪"]]`,
+		},
+		{
+			name:   "star",
+			update: func(c *api.Client) error { return starNotebook(c, "nb1", true) },
+			want:   `["nb1",[null,null,null,null,null,[null,null,null,null,null,null,null,true]]]`,
+		},
+		{
+			name:   "unstar sends false",
+			update: func(c *api.Client) error { return starNotebook(c, "nb1", false) },
+			want:   `["nb1",[null,null,null,null,null,[null,null,null,null,null,null,null,false]]]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mutated string
+			c := newTestClient(t, func(rpcID, freq string) string {
+				if rpcID != pb.NotebookLM_MutateProject_RPCID {
+					t.Errorf("unexpected RPC %s", rpcID)
+				}
+				mutated = freq
+				return `["Notebook",[],"nb1"]`
+			})
+			if err := tt.update(c); err != nil {
+				t.Fatalf("update error = %v", err)
+			}
+			if got := rpcArgs(t, mutated); got != tt.want {
+				t.Errorf("MutateProject args = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+)
+
+// saveNoteFlag is the --save-note[=title] flag of the generation commands.
+// Without a title the note is named after what was generated.
+type saveNoteFlag struct {
+	enabled bool
+	title   string
+}
+
+func addSaveNoteFlag(fs *flag.FlagSet) *saveNoteFlag {
+	f := &saveNoteFlag{}
+	fs.Var(f, "save-note", "also save the output as a note; name it with --save-note=title, the = is required")
+	return f
+}
+
+func (f *saveNoteFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.title
+}
+
+func (f *saveNoteFlag) Set(v string) error {
+	switch v {
+	case "true":
+		f.enabled = true
+	case "false":
+		f.enabled = false
+	default:
+		f.enabled, f.title = true, v
+	}
+	return nil
+}
+
+// IsBoolFlag lets the flag be given without a value.
+func (f *saveNoteFlag) IsBoolFlag() bool { return true }
+
+// save stores content as a note in the notebook if the flag is set. The
+// citations of a chat answer are kept as a list of sources below it.
+func (f *saveNoteFlag) save(c *api.Client, notebookID, defaultTitle, content string, citations []api.Citation) error {
+	if !f.enabled {
+		return nil
+	}
+	title := f.title
+	if title == "" {
+		title = defaultTitle
+	}
+	if len(citations) > 0 {
+		var b strings.Builder
+		b.WriteString(strings.TrimRight(content, "\n"))
+		b.WriteString("\n")
+		printCitations(&b, citations)
+		content = b.String()
+	}
+	if _, err := c.CreateNote(notebookID, title, content); err != nil {
+		return fmt.Errorf("save note: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "✅ Saved as note: %s\n", title)
+	return nil
+}
+
+// noteTitle shortens text to a single line usable as a note title.
+func noteTitle(text string) string {
+	const maxLen = 80
+	title := strings.Join(strings.Fields(text), " ")
+	if r := []rune(title); len(r) > maxLen {
+		title = string(r[:maxLen-1]) + "…"
+	}
+	return title
+}
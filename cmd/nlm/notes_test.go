@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSaveNoteFlagParsing(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		want      []string
+		wantTitle string
+		wantErr   bool
+	}{
+		{name: "title with =", args: []string{"nb1", "--save-note=My title", "question"}, want: []string{"nb1", "question"}, wantTitle: "My title"},
+		{name: "bare flag last", args: []string{"nb1", "question", "--save-note"}, want: []string{"nb1", "question"}},
+		{name: "bare flag before another flag", args: []string{"nb1", "--save-note", "--sources=s1", "question"}, want: []string{"nb1", "question"}},
+		{name: "bare flag before an argument", args: []string{"nb1", "--save-note", "My title", "question"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("ask", flag.ContinueOnError)
+			addSourceFlags(fs)
+			note := addSaveNoteFlag(fs)
+			got, err := splitFlags(fs, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("splitFlags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("positional arguments mismatch (-want +got):\n%s", diff)
+			}
+			if !note.enabled || note.title != tt.wantTitle {
+				t.Errorf("--save-note = %+v, want enabled with title %q", *note, tt.wantTitle)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultRefreshJobs bounds how many sources are refreshed at once.
+const defaultRefreshJobs = 4
+
+type refreshOutcome string
+
+const (
+	refreshRefreshed refreshOutcome = "refreshed"
+	refreshUnchanged refreshOutcome = "unchanged"
+	refreshFailed    refreshOutcome = "failed"
+)
+
+type refreshResult struct {
+	notebookID string
+	sourceID   string
+	title      string
+	modified   *timestamppb.Timestamp // before the refresh
+	outcome    refreshOutcome
+	reason     string
+}
+
+// refreshSources refreshes the Google Docs and Slides sources of one notebook,
+// or of every notebook if notebookID is empty, and prints a summary table.
+// A notebook that cannot be read is reported as a failed row.
+func refreshSources(c *api.Client, notebookID string, onlyStale bool, jobs int) error {
+	var notebookIDs []string
+	if notebookID != "" {
+		notebookIDs = []string{notebookID}
+	} else {
+		projects, err := c.ListRecentlyViewedProjects()
+		if err != nil {
+			return fmt.Errorf("list notebooks: %w", err)
+		}
+		for _, p := range projects {
+			notebookIDs = append(notebookIDs, p.ProjectId)
+		}
+	}
+
+	var results []*refreshResult
+	pending := 0
+	for _, id := range notebookIDs {
+		p, err := c.GetProject(id)
+		if err != nil {
+			// Report the notebook and carry on with the others.
+			results = append(results, &refreshResult{
+				notebookID: id,
+				sourceID:   "-",
+				title:      "-",
+				outcome:    refreshFailed,
+				reason:     "get notebook: " + err.Error(),
+			})
+			continue
+		}
+		for _, src := range p.Sources {
+			if !isDriveSource(src) {
+				continue
+			}
+			results = append(results, &refreshResult{
+				notebookID: id,
+				sourceID:   src.SourceId.GetSourceId(),
+				title:      strings.TrimSpace(src.Title),
+				modified:   src.Metadata.GetLastModifiedTime(),
+			})
+			pending++
+		}
+	}
+	if len(results) == 0 {
+		fmt.Println("No Google Docs or Slides sources to refresh")
+		return nil
+	}
+
+	if jobs < 1 {
+		jobs = 1
+	}
+	fmt.Fprintf(os.Stderr, "Refreshing %d sources...\n", pending)
+	sem := make(chan struct{}, jobs)
+	var wg sync.WaitGroup
+	for _, r := range results {
+		if r.outcome == refreshFailed {
+			continue // the notebook could not be read
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(r *refreshResult) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			refreshOne(c, r, onlyStale)
+		}(r)
+	}
+	wg.Wait()
+
+	counts := make(map[refreshOutcome]int)
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 4, ' ', 0)
+	fmt.Fprintln(w, "NOTEBOOK\tSOURCE\tTITLE\tRESULT\tREASON")
+	for _, r := range results {
+		counts[r.outcome]++
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.notebookID, r.sourceID, r.title, r.outcome, r.reason)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%d refreshed, %d unchanged, %d failed\n",
+		counts[refreshRefreshed], counts[refreshUnchanged], counts[refreshFailed])
+	if n := counts[refreshFailed]; n > 0 {
+		return fmt.Errorf("refresh: %d failed", n)
+	}
+	return nil
+}
+
+func refreshOne(c *api.Client, r *refreshResult, onlyStale bool) {
+	if onlyStale {
+		resp, err := c.CheckSourceFreshness(r.sourceID)
+		if err != nil {
+			r.outcome, r.reason = refreshFailed, err.Error()
+			return
+		}
+		if !resp.NeedsRefresh {
+			r.outcome, r.reason = refreshUnchanged, "up to date"
+			return
+		}
+	}
+
+	after, err := c.RefreshSource(r.sourceID)
+	if err != nil {
+		r.outcome, r.reason = refreshFailed, err.Error()
+		return
+	}
+	if t := after.Metadata.GetLastModifiedTime(); t != nil && proto.Equal(t, r.modified) {
+		r.outcome, r.reason = refreshUnchanged, "no changes in the original document"
+		return
+	}
+	r.outcome = refreshRefreshed
+	if t := after.Metadata.GetLastModifiedTime(); t != nil {
+		r.reason = "updated " + formatTimestamp(t)
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRefreshOne(t *testing.T) {
+	const (
+		sameTime  = `[["s1"],"Doc",[null,null,[1700000000,0],null,3]]`
+		newerTime = `[["s1"],"Doc",[null,null,[1700003600,0],null,3]]`
+		noTime    = `[["s1"],"Doc"]`
+	)
+	tests := []struct {
+		name       string
+		onlyStale  bool
+		freshness  string // CheckSourceFreshness response
+		refreshed  string // RefreshSource response
+		want       refreshOutcome
+		wantReason string
+	}{
+		{name: "newer document", refreshed: newerTime, want: refreshRefreshed, wantReason: "updated 2023-11-14T23:13:20Z"},
+		{name: "same modification time", refreshed: sameTime, want: refreshUnchanged, wantReason: "no changes in the original document"},
+		{name: "no modification time", refreshed: noTime, want: refreshRefreshed},
+		{name: "refresh fails", refreshed: `"not a source"`, want: refreshFailed},
+		{name: "stale", onlyStale: true, freshness: `[true]`, refreshed: newerTime, want: refreshRefreshed, wantReason: "updated 2023-11-14T23:13:20Z"},
+		{name: "up to date", onlyStale: true, freshness: `[false]`, want: refreshUnchanged, wantReason: "up to date"},
+		{name: "freshness check fails", onlyStale: true, freshness: `"not a response"`, want: refreshFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(rpcID, freq string) string {
+				switch rpcID {
+				case pb.NotebookLM_CheckSourceFreshness_RPCID:
+					return tt.freshness
+				case pb.NotebookLM_RefreshSource_RPCID:
+					if tt.refreshed == "" {
+						t.Error("source refreshed although it is up to date")
+					}
+					return tt.refreshed
+				}
+				t.Fatalf("unexpected RPC %s", rpcID)
+				return ""
+			})
+			r := &refreshResult{sourceID: "s1", modified: &timestamppb.Timestamp{Seconds: 1700000000}}
+			refreshOne(c, r, tt.onlyStale)
+			if r.outcome != tt.want {
+				t.Errorf("outcome = %s (%s), want %s", r.outcome, r.reason, tt.want)
+			}
+			if tt.want != refreshFailed && r.reason != tt.wantReason {
+				t.Errorf("reason = %q, want %q", r.reason, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+	"github.com/zbigniew-malinowski/nlm/internal/beprotojson"
+	"github.com/zbigniew-malinowski/nlm/internal/rpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func listRPCs() error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 4, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tSERVICE\tMODE\tIDEMPOTENT\tSCOPE\tRESPONSE")
+	for _, info := range rpc.List() {
+		mode := "mutating"
+		if info.ReadOnly {
+			mode = "read-only"
+		}
+		idempotent := "no"
+		if info.Idempotent {
+			idempotent = "yes"
+		}
+		scope := "global"
+		if info.NotebookScoped {
+			scope = "notebook"
+		}
+		response := info.ResponseType
+		if response == "" {
+			response = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			info.ID, info.Name, info.Service, mode, idempotent, scope, response)
+	}
+	return w.Flush()
+}
+
+// callRPC sends a raw call to the RPC named by idOrName, which may also be
+// an ID missing from the registry, and prints the response.
+func callRPC(c *api.Client, idOrName, jsonArgs, notebookID, decode string) error {
+	id := idOrName
+	info, known := rpc.Resolve(idOrName)
+	if known {
+		id = info.ID
+		if info.NotebookScoped && notebookID == "" {
+			fmt.Fprintf(os.Stderr, "nlm: warning: %s is notebook-scoped; consider --notebook\n", info.Name)
+		}
+	}
+
+	// Resolve the response type before sending, so a typo does not fail
+	// only after a mutating call has already run.
+	if decode == "auto" {
+		if info.ResponseType == "" {
+			return fmt.Errorf("no known response type for %s; pass --decode TYPE", idOrName)
+		}
+		decode = info.ResponseType
+	}
+	var mt protoreflect.MessageType
+	if decode != "" {
+		var err error
+		if mt, err = protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(decode)); err != nil {
+			return fmt.Errorf("decode: unknown message type %q", decode)
+		}
+	}
+
+	dec := json.NewDecoder(strings.NewReader(jsonArgs))
+	dec.UseNumber()
+	var args []interface{}
+	if err := dec.Decode(&args); err != nil {
+		return fmt.Errorf("parse args: expected a JSON array: %w", err)
+	}
+
+	resp, err := c.DoRPC(rpc.Call{
+		ID:         id,
+		Args:       args,
+		NotebookID: notebookID,
+	})
+	if err != nil {
+		return fmt.Errorf("rpc %s: %w", id, err)
+	}
+
+	if mt == nil {
+		var out bytes.Buffer
+		if err := json.Indent(&out, resp, "", "  "); err != nil {
+			// Not valid JSON; print it verbatim.
+			fmt.Println(string(resp))
+			return nil
+		}
+		fmt.Println(out.String())
+		return nil
+	}
+
+	msg := mt.New().Interface()
+	if err := beprotojson.Unmarshal(resp, msg); err != nil {
+		return fmt.Errorf("decode %s: %w", decode, err)
+	}
+	out, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("decode %s: %w", decode, err)
+	}
+	fmt.Println(string(out))
+	return nil
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+func TestCallRPC(t *testing.T) {
+	tests := []struct {
+		name     string
+		idOrName string
+		decode   string
+		wantID   string // RPC sent; empty when nothing must be sent
+		wantErr  bool
+	}{
+		{name: "by name", idOrName: "GetProject", decode: "auto", wantID: pb.NotebookLM_GetProject_RPCID},
+		{name: "by ID", idOrName: pb.NotebookLM_GetProject_RPCID, decode: "notebooklm.v1alpha1.Project", wantID: pb.NotebookLM_GetProject_RPCID},
+		{name: "unregistered ID", idOrName: "zZz9Q", wantID: "zZz9Q"},
+		{name: "unknown decode type", idOrName: "GetProject", decode: "notebooklm.v1alpha1.Nope", wantErr: true},
+		{name: "auto without a response type", idOrName: "zZz9Q", decode: "auto", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent string
+			c := newTestClient(t, func(rpcID, freq string) string {
+				sent = rpcID
+				return `["Notebook",[],"nb1"]`
+			})
+			err := callRPC(c, tt.idOrName, `["nb1"]`, "nb1", tt.decode)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("callRPC() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if sent != tt.wantID {
+				t.Errorf("sent RPC %q, want %q", sent, tt.wantID)
+			}
+		})
+	}
+}
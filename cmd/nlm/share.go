@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+)
+
+// shareNotebook changes the link access of a notebook, or leaves it as is if
+// access is unspecified, and prints the share URL.
+func shareNotebook(c *api.Client, notebookID string, access pb.ProjectAccess) error {
+	fmt.Fprintf(os.Stderr, "Generating share link...\n")
+	resp, err := c.ShareProject(notebookID, access)
+	if err != nil {
+		return fmt.Errorf("share notebook: %w", err)
+	}
+	switch access {
+	case pb.ProjectAccess_PROJECT_ACCESS_ANYONE_WITH_LINK:
+		fmt.Printf("✅ Anyone with the link can now view the notebook\n")
+	case pb.ProjectAccess_PROJECT_ACCESS_RESTRICTED:
+		fmt.Printf("✅ Only collaborators can now open the notebook\n")
+	}
+	fmt.Printf("Share URL: %s\n", resp.ShareUrl)
+	return nil
+}
+
+func showShareInfo(c *api.Client, notebookID string) error {
+	details, err := c.GetProjectDetails(notebookID)
+	if err != nil {
+		return fmt.Errorf("share info: %w", err)
+	}
+	fmt.Printf("Notebook:  %s\n", strings.TrimSpace(details.Title))
+	fmt.Printf("Owner:     %s\n", details.OwnerEmail)
+	fmt.Printf("Access:    %s\n", accessString(details.Access))
+	if details.ShareUrl != "" {
+		fmt.Printf("Share URL: %s\n", details.ShareUrl)
+	}
+	return nil
+}
+
+func accessString(a pb.ProjectAccess) string {
+	switch a {
+	case pb.ProjectAccess_PROJECT_ACCESS_RESTRICTED:
+		return "restricted"
+	case pb.ProjectAccess_PROJECT_ACCESS_ANYONE_WITH_LINK:
+		return "anyone with the link"
+	default:
+		return "unknown"
+	}
+}
+
+func listCollaborators(c *api.Client, notebookID string) error {
+	collaborators, err := c.ListCollaborators(notebookID)
+	if err != nil {
+		return fmt.Errorf("list collaborators: %w", err)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 4, ' ', 0)
+	fmt.Fprintln(w, "EMAIL\tNAME\tROLE")
+	for _, collab := range collaborators {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", collab.Email, collab.DisplayName, roleString(collab.Role))
+	}
+	return w.Flush()
+}
+
+func addCollaborator(c *api.Client, notebookID, email, role string) error {
+	r, err := parseRole(role)
+	if err != nil {
+		return err
+	}
+	if err := c.AddCollaborator(notebookID, email, r); err != nil {
+		return fmt.Errorf("add collaborator: %w", err)
+	}
+	fmt.Printf("✅ Shared notebook with %s as %s\n", email, roleString(r))
+	return nil
+}
+
+func removeCollaborator(c *api.Client, notebookID, email string) error {
+	fmt.Printf("Are you sure you want to revoke access for %s? [y/N] ", email)
+	var response string
+	fmt.Scanln(&response)
+	if !strings.HasPrefix(strings.ToLower(response), "y") {
+		return fmt.Errorf("operation cancelled")
+	}
+	if err := c.RemoveCollaborator(notebookID, email); err != nil {
+		return fmt.Errorf("remove collaborator: %w", err)
+	}
+	fmt.Printf("✅ Revoked access for %s\n", email)
+	return nil
+}
+
+func setCollaboratorRole(c *api.Client, notebookID, email, role string) error {
+	r, err := parseRole(role)
+	if err != nil {
+		return err
+	}
+	if err := c.SetCollaboratorRole(notebookID, email, r); err != nil {
+		return fmt.Errorf("set role: %w", err)
+	}
+	fmt.Printf("✅ %s is now %s\n", email, roleString(r))
+	return nil
+}
+
+func parseRole(s string) (pb.ProjectRole, error) {
+	switch strings.ToLower(s) {
+	case "viewer":
+		return pb.ProjectRole_PROJECT_ROLE_VIEWER, nil
+	case "editor":
+		return pb.ProjectRole_PROJECT_ROLE_EDITOR, nil
+	}
+	return 0, fmt.Errorf("invalid role %q: must be viewer or editor", s)
+}
+
+func roleString(r pb.ProjectRole) string {
+	switch r {
+	case pb.ProjectRole_PROJECT_ROLE_OWNER:
+		return "owner"
+	case pb.ProjectRole_PROJECT_ROLE_EDITOR:
+		return "editor"
+	case pb.ProjectRole_PROJECT_ROLE_VIEWER:
+		return "viewer"
+	default:
+		return "-"
+	}
+}
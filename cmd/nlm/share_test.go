@@ -0,0 +1,60 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+func TestShareNotebook(t *testing.T) {
+	tests := []struct {
+		name   string
+		access pb.ProjectAccess
+		want   string // f.req args of ShareProject
+	}{
+		{name: "public", access: pb.ProjectAccess_PROJECT_ACCESS_ANYONE_WITH_LINK, want: `["nb1",2]`},
+		{name: "private", access: pb.ProjectAccess_PROJECT_ACCESS_RESTRICTED, want: `["nb1",1]`},
+		{name: "link only leaves access unchanged", want: `["nb1"]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var shared string
+			c := newTestClient(t, func(rpcID, freq string) string {
+				if rpcID != pb.NotebookLMSharing_ShareProject_RPCID {
+					t.Errorf("unexpected RPC %s", rpcID)
+				}
+				shared = freq
+				return `["https://notebooklm.google.com/notebook/nb1"]`
+			})
+			if err := shareNotebook(c, "nb1", tt.access); err != nil {
+				t.Fatalf("shareNotebook() error = %v", err)
+			}
+			if got := rpcArgs(t, shared); got != tt.want {
+				t.Errorf("ShareProject args = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRole(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    pb.ProjectRole
+		wantErr bool
+	}{
+		{in: "viewer", want: pb.ProjectRole_PROJECT_ROLE_VIEWER},
+		{in: "Editor", want: pb.ProjectRole_PROJECT_ROLE_EDITOR},
+		{in: "owner", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseRole(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseRole(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseRole(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+)
+
+// showSource writes the text NotebookLM extracted from a source.
+func showSource(c *api.Client, sourceID, output string) error {
+	resp, err := c.LoadSource(sourceID)
+	if err != nil {
+		return fmt.Errorf("load source: %w", err)
+	}
+	chunks := resp.GetContent().GetChunks()
+	if len(chunks) == 0 {
+		return fmt.Errorf("source %s has no extracted text", sourceID)
+	}
+	if title := strings.TrimSpace(resp.GetSource().GetTitle()); title != "" {
+		fmt.Fprintf(os.Stderr, "Source: %s\n", title)
+	}
+
+	return writeOutput(output, func(w io.Writer) error {
+		for _, chunk := range chunks {
+			if _, err := fmt.Fprintln(w, chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// showSourceGuides writes the summary and key topics of every source in a
+// notebook, or of a single source if sourceID is set.
+func showSourceGuides(c *api.Client, notebookID, sourceID, output string) error {
+	fmt.Fprintf(os.Stderr, "Generating source guides...\n")
+	resp, err := c.GenerateDocumentGuides(notebookID)
+	if err != nil {
+		return fmt.Errorf("generate source guides: %w", err)
+	}
+	p, err := c.GetProject(notebookID)
+	if err != nil {
+		return fmt.Errorf("get notebook: %w", err)
+	}
+	titles := make(map[string]string, len(p.Sources))
+	for _, src := range p.Sources {
+		titles[src.SourceId.GetSourceId()] = strings.TrimSpace(src.Title)
+	}
+
+	guides := resp.Guides
+	if sourceID != "" {
+		guides = nil
+		for _, guide := range resp.Guides {
+			if guide.GetSourceId().GetSourceId() == sourceID {
+				guides = append(guides, guide)
+			}
+		}
+		if len(guides) == 0 {
+			return fmt.Errorf("no guide for source %s", sourceID)
+		}
+	}
+
+	return writeOutput(output, func(w io.Writer) error {
+		for _, guide := range guides {
+			id := guide.GetSourceId().GetSourceId()
+			title := titles[id]
+			if title == "" {
+				title = id
+			}
+			fmt.Fprintf(w, "## %s\n\n%s\n\n", title, strings.TrimSpace(guide.Content))
+			if len(guide.KeyTopics) > 0 {
+				fmt.Fprintf(w, "Key topics:\n")
+				for _, topic := range guide.KeyTopics {
+					fmt.Fprintf(w, "- %s\n", topic)
+				}
+				fmt.Fprintln(w)
+			}
+		}
+		return nil
+	})
+}
+
+// sourceSelection holds the --sources and --sources-matching flags of the
+// chat and generation commands.
+type sourceSelection struct {
+	ids     string
+	pattern string
+}
+
+func addSourceFlags(fs *flag.FlagSet) *sourceSelection {
+	s := &sourceSelection{}
+	fs.StringVar(&s.ids, "sources", "", "comma-separated source IDs to use instead of every source")
+	fs.StringVar(&s.pattern, "sources-matching", "", "use the sources whose title matches this glob")
+	return s
+}
+
+// resolve returns the selected source IDs, or nil to use every source.
+func (s *sourceSelection) resolve(c *api.Client, notebookID string) ([]string, error) {
+	if s.ids != "" && s.pattern != "" {
+		return nil, fmt.Errorf("use either --sources or --sources-matching")
+	}
+	if s.ids == "" && s.pattern == "" {
+		return nil, nil
+	}
+	pattern := strings.ToLower(s.pattern)
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid --sources-matching pattern %q: %w", s.pattern, err)
+	}
+	p, err := c.GetProject(notebookID)
+	if err != nil {
+		return nil, fmt.Errorf("get notebook: %w", err)
+	}
+
+	var ids []string
+	if s.ids != "" {
+		known := make(map[string]bool, len(p.Sources))
+		for _, src := range p.Sources {
+			known[src.SourceId.GetSourceId()] = true
+		}
+		for _, id := range strings.Split(s.ids, ",") {
+			if id = strings.TrimSpace(id); id == "" {
+				continue
+			}
+			if !known[id] {
+				return nil, fmt.Errorf("notebook %s has no source %s", notebookID, id)
+			}
+			ids = append(ids, id)
+		}
+		if len(ids) == 0 {
+			return nil, fmt.Errorf("--sources lists no source IDs")
+		}
+		return ids, nil
+	}
+
+	for _, src := range p.Sources {
+		if ok, _ := path.Match(pattern, strings.ToLower(strings.TrimSpace(src.Title))); ok {
+			ids = append(ids, src.SourceId.GetSourceId())
+		}
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no sources match %q", s.pattern)
+	}
+	fmt.Fprintf(os.Stderr, "Using %d of %d sources matching %q\n", len(ids), len(p.Sources), s.pattern)
+	return ids, nil
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+func TestSourceSelectionResolve(t *testing.T) {
+	c := newTestClient(t, func(rpcID, freq string) string {
+		return `["Notebook",[[["src1"],"Meeting Notes"],[["src2"],"meeting agenda"],[["src3"],"Budget"]],"nb1"]`
+	})
+
+	tests := []struct {
+		name    string
+		sel     sourceSelection
+		want    []string
+		wantErr bool
+	}{
+		{name: "no flags", sel: sourceSelection{}, want: nil},
+		{name: "ids trimmed", sel: sourceSelection{ids: " src1, ,src3 "}, want: []string{"src1", "src3"}},
+		{name: "only commas", sel: sourceSelection{ids: ", ,"}, wantErr: true},
+		{name: "unknown id", sel: sourceSelection{ids: "src1,src9"}, wantErr: true},
+		{name: "glob ignores case", sel: sourceSelection{pattern: "MEETING*"}, want: []string{"src1", "src2"}},
+		{name: "invalid pattern", sel: sourceSelection{pattern: "[meeting"}, wantErr: true},
+		{name: "no match", sel: sourceSelection{pattern: "*report*"}, wantErr: true},
+		{name: "both flags", sel: sourceSelection{ids: "src1", pattern: "*"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.sel.resolve(c, "nb1")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolve() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("resolve() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetSourcesEnabledOnly(t *testing.T) {
+	var mutated []string
+	c := newTestClient(t, func(rpcID, freq string) string {
+		if rpcID == pb.NotebookLM_MutateSource_RPCID {
+			mutated = append(mutated, rpcArgs(t, freq))
+			return `[]`
+		}
+		return `["Notebook",[[["src1"],"A"],[["src2"],"B"],[["src3"],"C"]],"nb1"]`
+	})
+
+	if err := setSourcesEnabled(c, "nb1", []string{"src2"}, true, true); err != nil {
+		t.Fatalf("setSourcesEnabled() error = %v", err)
+	}
+	want := []string{
+		`["src2",[null,null,null,[null,1]]]`,
+		`["src1",[null,null,null,[null,2]]]`,
+		`["src3",[null,null,null,[null,2]]]`,
+	}
+	if diff := cmp.Diff(want, mutated); diff != "" {
+		t.Errorf("MutateSource calls mismatch (-want +got):\n%s", diff)
+	}
+
+	mutated = nil
+	err := setSourcesEnabled(c, "nb1", []string{"src9", "src1", "src0"}, true, false)
+	if want := "notebook nb1 has no source src0, src9"; err == nil || err.Error() != want {
+		t.Errorf("setSourcesEnabled() with unknown sources error = %v, want %q", err, want)
+	}
+	if len(mutated) != 0 {
+		t.Errorf("sources changed before the IDs were checked: %q", mutated)
+	}
+}
+
+func TestSetSourcesEnabledReportsPartialUpdate(t *testing.T) {
+	c := newTestClient(t, func(rpcID, freq string) string {
+		if rpcID == pb.NotebookLM_MutateSource_RPCID {
+			if strings.HasPrefix(rpcArgs(t, freq), `["src3"`) {
+				return `"not a source"`
+			}
+			return `[]`
+		}
+		return `["Notebook",[[["src1"],"A"],[["src2"],"B"],[["src3"],"C"]],"nb1"]`
+	})
+
+	err := setSourcesEnabled(c, "nb1", []string{"src1", "src2", "src3"}, false, false)
+	if err == nil || !strings.Contains(err.Error(), "already updated: src1, src2") {
+		t.Errorf("setSourcesEnabled() error = %v, want it to list src1 and src2 as updated", err)
+	}
+}
+
+func TestShowSourceGuides(t *testing.T) {
+	c := newTestClient(t, func(rpcID, freq string) string {
+		switch rpcID {
+		case pb.NotebookLM_GenerateDocumentGuides_RPCID:
+			return `[[` +
+				`["Minutes of the kickoff.",["scope","owners"],["src1"]],` +
+				`["A pasted snippet.",[],["src9"]]` +
+				`]]`
+		case pb.NotebookLM_GetProject_RPCID:
+			return `["Notebook",[[["src1"],"Meeting Notes "]],"nb1"]`
+		}
+		t.Errorf("unexpected RPC %s", rpcID)
+		return `[]`
+	})
+
+	tests := []struct {
+		name     string
+		sourceID string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name: "every source",
+			want: "## Meeting Notes\n\nMinutes of the kickoff.\n\nKey topics:\n- scope\n- owners\n\n" +
+				"## src9\n\nA pasted snippet.\n\n",
+		},
+		{
+			name:     "one source",
+			sourceID: "src9",
+			want:     "## src9\n\nA pasted snippet.\n\n",
+		},
+		{
+			name:     "source without a guide",
+			sourceID: "src2",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := filepath.Join(t.TempDir(), "guides.md")
+			err := showSourceGuides(c, "nb1", tt.sourceID, output)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("showSourceGuides() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got, err := os.ReadFile(output)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("output =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/api"
+)
+
+type flashcardJSON struct {
+	Front   string   `json:"front"`
+	Back    string   `json:"back"`
+	Sources []string `json:"sources,omitempty"`
+}
+
+type quizQuestionJSON struct {
+	Question    string   `json:"question"`
+	Options     []string `json:"options"`
+	Answer      int      `json:"answer"` // index into options
+	Explanation string   `json:"explanation,omitempty"`
+	Sources     []string `json:"sources,omitempty"`
+}
+
+// showFlashcards generates flashcards from a notebook and writes them as an
+// Anki import file, JSON or Markdown.
+func showFlashcards(c *api.Client, notebookID string, sources *sourceSelection, note *saveNoteFlag, format, output string) error {
+	if format != "anki-csv" && format != "json" && format != "md" {
+		return fmt.Errorf("invalid format %q: must be anki-csv, json or md", format)
+	}
+	sourceIDs, err := sources.resolve(c, notebookID)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Generating flashcards...\n")
+	cards, err := c.GenerateFlashcards(notebookID, sourceIDs...)
+	if err != nil {
+		return fmt.Errorf("generate flashcards: %w", err)
+	}
+	if len(cards) == 0 {
+		return fmt.Errorf("no flashcards were generated")
+	}
+
+	if err := writeOutput(output, func(w io.Writer) error {
+		switch format {
+		case "anki-csv":
+			return writeFlashcardsAnki(w, cards)
+		case "json":
+			out := make([]flashcardJSON, 0, len(cards))
+			for _, card := range cards {
+				out = append(out, flashcardJSON{Front: card.Front, Back: card.Back, Sources: sourceIDStrings(card.SourceIds)})
+			}
+			return writeJSON(w, out)
+		default:
+			_, err := io.WriteString(w, flashcardsMarkdown(cards))
+			return err
+		}
+	}); err != nil {
+		return err
+	}
+	return note.save(c, notebookID, "Flashcards", flashcardsMarkdown(cards), nil)
+}
+
+// writeFlashcardsAnki writes cards in the CSV format of Anki's "Import File",
+// with file headers so no import options need to be set by hand.
+func writeFlashcardsAnki(w io.Writer, cards []*pb.Flashcard) error {
+	if _, err := io.WriteString(w, "#separator:Comma\n#html:false\n#columns:Front,Back\n"); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	for _, card := range cards {
+		if err := cw.Write([]string{card.Front, card.Back}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func flashcardsMarkdown(cards []*pb.Flashcard) string {
+	var b strings.Builder
+	b.WriteString("# Flashcards\n")
+	for i, card := range cards {
+		fmt.Fprintf(&b, "\n## %d. %s\n\n%s\n", i+1, strings.TrimSpace(card.Front), strings.TrimSpace(card.Back))
+	}
+	return b.String()
+}
+
+// showQuiz generates a multiple-choice quiz from a notebook and writes it,
+// with an answer key, as Markdown or JSON.
+func showQuiz(c *api.Client, notebookID string, sources *sourceSelection, note *saveNoteFlag, format, output string) error {
+	if format != "md" && format != "json" {
+		return fmt.Errorf("invalid format %q: must be md or json", format)
+	}
+	questions, err := generateQuiz(c, notebookID, sources)
+	if err != nil {
+		return err
+	}
+	if err := writeOutput(output, func(w io.Writer) error {
+		if format == "json" {
+			out := make([]quizQuestionJSON, 0, len(questions))
+			for _, q := range questions {
+				out = append(out, quizQuestionJSON{
+					Question:    q.Question,
+					Options:     q.Options,
+					Answer:      int(q.AnswerIndex),
+					Explanation: q.Explanation,
+					Sources:     sourceIDStrings(q.SourceIds),
+				})
+			}
+			return writeJSON(w, out)
+		}
+		_, err := io.WriteString(w, quizMarkdown(questions))
+		return err
+	}); err != nil {
+		return err
+	}
+	return note.save(c, notebookID, "Quiz", quizMarkdown(questions), nil)
+}
+
+// runQuiz generates a quiz and asks its questions one by one in the
+// terminal, then prints the score.
+func runQuiz(c *api.Client, notebookID string, sources *sourceSelection) error {
+	questions, err := generateQuiz(c, notebookID, sources)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Answer with the letter of an option, or q to stop.\n")
+	return playQuiz(os.Stdin, os.Stdout, questions)
+}
+
+// playQuiz asks questions on w, reading the answers from r, until every
+// question is answered, q is typed or r ends. It then writes the score.
+func playQuiz(r io.Reader, w io.Writer, questions []*pb.QuizQuestion) error {
+	in := bufio.NewScanner(r)
+	correct, asked := 0, 0
+quiz:
+	for i, q := range questions {
+		fmt.Fprintf(w, "\n%d/%d. %s\n", i+1, len(questions), strings.TrimSpace(q.Question))
+		for j, opt := range q.Options {
+			fmt.Fprintf(w, "  %c) %s\n", 'a'+j, opt)
+		}
+		for {
+			fmt.Fprint(w, "> ")
+			if !in.Scan() {
+				fmt.Fprintln(w)
+				break quiz
+			}
+			answer := strings.ToLower(strings.TrimSpace(in.Text()))
+			if answer == "q" {
+				break quiz
+			}
+			if len(answer) != 1 || answer[0] < 'a' || int(answer[0]-'a') >= len(q.Options) {
+				fmt.Fprintf(w, "Please answer a-%c\n", 'a'+len(q.Options)-1)
+				continue
+			}
+			asked++
+			if int(answer[0]-'a') == int(q.AnswerIndex) {
+				correct++
+				fmt.Fprintf(w, "✅ Correct\n")
+			} else {
+				fmt.Fprintf(w, "❌ The answer is %s\n", quizAnswer(q))
+			}
+			if q.Explanation != "" {
+				fmt.Fprintf(w, "%s\n", strings.TrimSpace(q.Explanation))
+			}
+			break
+		}
+	}
+	if err := in.Err(); err != nil {
+		return fmt.Errorf("read answer: %w", err)
+	}
+
+	if asked == 0 {
+		fmt.Fprintf(w, "\nNo questions answered\n")
+		return nil
+	}
+	fmt.Fprintf(w, "\nScore: %d/%d (%d%%)\n", correct, asked, correct*100/asked)
+	return nil
+}
+
+// generateQuiz generates the questions of a quiz, leaving out any that have
+// no options to choose from.
+func generateQuiz(c *api.Client, notebookID string, sources *sourceSelection) ([]*pb.QuizQuestion, error) {
+	sourceIDs, err := sources.resolve(c, notebookID)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(os.Stderr, "Generating quiz...\n")
+	generated, err := c.GenerateQuiz(notebookID, sourceIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("generate quiz: %w", err)
+	}
+	var questions []*pb.QuizQuestion
+	for _, q := range generated {
+		if len(q.Options) > 0 {
+			questions = append(questions, q)
+		}
+	}
+	if len(questions) == 0 {
+		return nil, fmt.Errorf("no quiz questions were generated")
+	}
+	return questions, nil
+}
+
+func quizMarkdown(questions []*pb.QuizQuestion) string {
+	var b strings.Builder
+	b.WriteString("# Quiz\n")
+	for i, q := range questions {
+		fmt.Fprintf(&b, "\n## %d. %s\n\n", i+1, strings.TrimSpace(q.Question))
+		for j, opt := range q.Options {
+			fmt.Fprintf(&b, "%c) %s\n", 'a'+j, opt)
+		}
+	}
+	b.WriteString("\n## Answers\n\n")
+	for i, q := range questions {
+		fmt.Fprintf(&b, "%d. %s", i+1, quizAnswer(q))
+		if q.Explanation != "" {
+			fmt.Fprintf(&b, ": %s", strings.TrimSpace(q.Explanation))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// quizAnswer returns the correct option of q, labelled with its letter.
+func quizAnswer(q *pb.QuizQuestion) string {
+	i := int(q.AnswerIndex)
+	if i < 0 || i >= len(q.Options) {
+		return "unknown"
+	}
+	return fmt.Sprintf("%c) %s", 'a'+i, q.Options[i])
+}
+
+func sourceIDStrings(ids []*pb.SourceId) []string {
+	var out []string
+	for _, id := range ids {
+		out = append(out, id.GetSourceId())
+	}
+	return out
+}
+
+func writeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(v)
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+func TestPlayQuiz(t *testing.T) {
+	questions := []*pb.QuizQuestion{
+		{Question: "2+2?", Options: []string{"3", "4"}, AnswerIndex: 1, Explanation: "Basic sums."},
+		{Question: "Capital of France?", Options: []string{"Paris", "Rome", "Oslo"}, AnswerIndex: 0},
+		{Question: "Largest planet?", Options: []string{"Mars", "Jupiter"}, AnswerIndex: 1},
+	}
+
+	tests := []struct {
+		name      string
+		answers   string
+		wantScore string
+		wantLines []string
+	}{
+		{
+			name:      "all correct",
+			answers:   "b\nA\nb\n",
+			wantScore: "Score: 3/3 (100%)",
+			wantLines: []string{"✅ Correct", "Basic sums."},
+		},
+		{
+			name:      "wrong answer",
+			answers:   "a\na\na\n",
+			wantScore: "Score: 1/3 (33%)",
+			wantLines: []string{"❌ The answer is b) 4", "❌ The answer is b) Jupiter"},
+		},
+		{
+			name:      "invalid answers are asked again",
+			answers:   "z\nbb\n\nb\na\nb\n",
+			wantScore: "Score: 3/3 (100%)",
+			wantLines: []string{"Please answer a-b"},
+		},
+		{
+			name:      "quit",
+			answers:   "b\nq\nb\n",
+			wantScore: "Score: 1/1 (100%)",
+		},
+		{
+			name:      "end of input",
+			answers:   "b\nc",
+			wantScore: "Score: 1/2 (50%)",
+		},
+		{
+			name:      "nothing answered",
+			answers:   "",
+			wantScore: "No questions answered",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := playQuiz(strings.NewReader(tt.answers), &out, questions); err != nil {
+				t.Fatalf("playQuiz() error = %v", err)
+			}
+			lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+			if got := lines[len(lines)-1]; got != tt.wantScore {
+				t.Errorf("last line = %q, want %q", got, tt.wantScore)
+			}
+			for _, want := range tt.wantLines {
+				if !strings.Contains(out.String(), want+"\n") {
+					t.Errorf("output lacks %q:\n%s", want, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestWriteFlashcardsAnki(t *testing.T) {
+	cards := []*pb.Flashcard{
+		{Front: "What is 2+2?", Back: "4"},
+		{Front: "Define \"entropy\"", Back: "Disorder, roughly"},
+		{Front: "List two primes", Back: "2\n3"},
+	}
+	var out bytes.Buffer
+	if err := writeFlashcardsAnki(&out, cards); err != nil {
+		t.Fatalf("writeFlashcardsAnki() error = %v", err)
+	}
+	want := `#separator:Comma
+#html:false
+#columns:Front,Back
+What is 2+2?,4
+"Define ""entropy""","Disorder, roughly"
+List two primes,"2
+3"
+`
+	if diff := cmp.Diff(want, out.String()); diff != "" {
+		t.Errorf("writeFlashcardsAnki() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestQuizMarkdown(t *testing.T) {
+	questions := []*pb.QuizQuestion{
+		{Question: "2+2?", Options: []string{"3", "4"}, AnswerIndex: 1, Explanation: "Basic sums."},
+		{Question: "Capital of France?", Options: []string{"Paris", "Rome"}, AnswerIndex: 5},
+	}
+	want := `# Quiz
+
+## 1. 2+2?
+
+a) 3
+b) 4
+
+## 2. Capital of France?
+
+a) Paris
+b) Rome
+
+## Answers
+
+1. b) 4: Basic sums.
+2. unknown
+`
+	if diff := cmp.Diff(want, quizMarkdown(questions)); diff != "" {
+		t.Errorf("quizMarkdown() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGenerateQuizSkipsQuestionsWithoutOptions(t *testing.T) {
+	c := newTestClient(t, func(rpcID, freq string) string {
+		return `[[["No options",[],0],["2+2?",["3","4"],1]]]`
+	})
+	questions, err := generateQuiz(c, "nb1", &sourceSelection{})
+	if err != nil {
+		t.Fatalf("generateQuiz() error = %v", err)
+	}
+	if len(questions) != 1 || questions[0].Question != "2+2?" {
+		t.Errorf("generateQuiz() = %v, want only the question with options", questions)
+	}
+}
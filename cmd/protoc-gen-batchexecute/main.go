@@ -0,0 +1,267 @@
+// Command protoc-gen-batchexecute generates typed batchexecute clients from
+// service definitions.
+//
+// Every method must carry the (notebooklm.v1alpha1.rpc_id) option. Requests
+// are encoded with beprotojson, and a request field marked with
+// (notebooklm.v1alpha1.notebook_id) sets the source-path of the call.
+//
+// Each method is also registered with the internal/rpc registry. A method
+// with idempotency_level NO_SIDE_EFFECTS is registered as read-only and
+// idempotent, one with IDEMPOTENT as idempotent only. A method is
+// notebook-scoped if its request has a (notebook_id) field or the method
+// carries (notebooklm.v1alpha1.notebook_scoped).
+//
+// Install it on PATH and run buf generate from the proto directory:
+//
+//	go install ./cmd/protoc-gen-batchexecute
+//	cd proto && buf generate
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	contextPackage     = protogen.GoImportPath("context")
+	fmtPackage         = protogen.GoImportPath("fmt")
+	rpcPackage         = protogen.GoImportPath("github.com/zbigniew-malinowski/nlm/internal/rpc")
+	beprotojsonPackage = protogen.GoImportPath("github.com/zbigniew-malinowski/nlm/internal/beprotojson")
+)
+
+// Options defined in notebooklm/v1alpha1/rpc_extensions.proto. They are
+// read through descriptors from the request rather than the generated Go
+// package, so the plugin builds even when gen/ is missing or stale.
+const (
+	rpcIDOption          = protoreflect.FullName("notebooklm.v1alpha1.rpc_id")
+	notebookIDOption     = protoreflect.FullName("notebooklm.v1alpha1.notebook_id")
+	notebookScopedOption = protoreflect.FullName("notebooklm.v1alpha1.notebook_scoped")
+)
+
+// extensions holds the extensions declared by the files in the request.
+var extensions = new(protoregistry.Types)
+
+func main() {
+	protogen.Options{}.Run(func(gen *protogen.Plugin) error {
+		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+		for _, f := range gen.Files {
+			exts := f.Desc.Extensions()
+			for i := 0; i < exts.Len(); i++ {
+				if err := extensions.RegisterExtension(dynamicpb.NewExtensionType(exts.Get(i))); err != nil {
+					return err
+				}
+			}
+		}
+		for _, f := range gen.Files {
+			if !f.Generate || len(f.Services) == 0 {
+				continue
+			}
+			if err := generateFile(gen, f); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func generateFile(gen *protogen.Plugin, file *protogen.File) error {
+	g := gen.NewGeneratedFile(file.GeneratedFilenamePrefix+"_batchexecute.pb.go", file.GoImportPath)
+	g.P("// Code generated by protoc-gen-batchexecute. DO NOT EDIT.")
+	g.P("// source: ", file.Desc.Path())
+	g.P()
+	g.P("package ", file.GoPackageName)
+	g.P()
+
+	for _, svc := range file.Services {
+		if err := generateService(g, svc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func generateService(g *protogen.GeneratedFile, svc *protogen.Service) error {
+	clientName := svc.GoName + "Client"
+
+	g.P("// RPC IDs for the ", svc.GoName, " service.")
+	g.P("const (")
+	for _, m := range svc.Methods {
+		id, err := rpcID(m)
+		if err != nil {
+			return err
+		}
+		g.P(rpcIDConst(m), " = ", fmt.Sprintf("%q", id))
+	}
+	g.P(")")
+	g.P()
+
+	if err := generateRegistry(g, svc); err != nil {
+		return err
+	}
+
+	g.P("// ", clientName, " is a typed batchexecute client for the ", svc.GoName, " service.")
+	g.P("type ", clientName, " struct {")
+	g.P("rpc *", rpcPackage.Ident("Client"))
+	g.P("}")
+	g.P()
+	g.P("// New", clientName, " returns a ", clientName, " that sends calls through c.")
+	g.P("func New", clientName, "(c *", rpcPackage.Ident("Client"), ") *", clientName, " {")
+	g.P("return &", clientName, "{rpc: c}")
+	g.P("}")
+	g.P()
+
+	for _, m := range svc.Methods {
+		if err := generateMethod(g, clientName, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func generateRegistry(g *protogen.GeneratedFile, svc *protogen.Service) error {
+	g.P("func init() {")
+	g.P(rpcPackage.Ident("Register"), "(")
+	for _, m := range svc.Methods {
+		nbField, err := notebookIDField(m.Input)
+		if err != nil {
+			return err
+		}
+		level := m.Desc.Options().(*descriptorpb.MethodOptions).GetIdempotencyLevel()
+		g.P(rpcPackage.Ident("Info"), "{")
+		g.P("ID: ", rpcIDConst(m), ",")
+		g.P("Name: ", fmt.Sprintf("%q", m.Desc.Name()), ",")
+		g.P("Service: ", fmt.Sprintf("%q", svc.Desc.Name()), ",")
+		if level == descriptorpb.MethodOptions_NO_SIDE_EFFECTS {
+			g.P("ReadOnly: true,")
+		}
+		if level != descriptorpb.MethodOptions_IDEMPOTENCY_UNKNOWN {
+			g.P("Idempotent: true,")
+		}
+		if scoped, _ := option(m.Desc.Options(), notebookScopedOption).Interface().(bool); scoped || nbField != nil {
+			g.P("NotebookScoped: true,")
+		}
+		if m.Output.Desc.FullName() != "google.protobuf.Empty" {
+			g.P("ResponseType: ", fmt.Sprintf("%q", m.Output.Desc.FullName()), ",")
+		}
+		g.P("},")
+	}
+	g.P(")")
+	g.P("}")
+	g.P()
+	return nil
+}
+
+func generateMethod(g *protogen.GeneratedFile, clientName string, m *protogen.Method) error {
+	if m.Desc.IsStreamingClient() || m.Desc.IsStreamingServer() {
+		return fmt.Errorf("%s: streaming methods are not supported", m.Desc.FullName())
+	}
+	nbField, err := notebookIDField(m.Input)
+	if err != nil {
+		return err
+	}
+
+	g.P("// ", m.GoName, " calls ", m.Parent.GoName, ".", m.GoName, ".")
+	if m.Comments.Leading != "" {
+		g.P("//")
+		g.P(strings.TrimSuffix(m.Comments.Leading.String(), "\n"))
+	}
+	g.P("func (c *", clientName, ") ", m.GoName, "(ctx ", contextPackage.Ident("Context"), ", in *", m.Input.GoIdent, ") (*", m.Output.GoIdent, ", error) {")
+	g.P("args, err := ", rpcPackage.Ident("ArgsFromProto"), "(in)")
+	g.P("if err != nil {")
+	g.P("return nil, ", fmtPackage.Ident("Errorf"), `("encode args: %w", err)`)
+	g.P("}")
+	// Methods returning Empty often reply with no payload at all, so the
+	// response is not decoded for them.
+	empty := m.Output.Desc.FullName() == "google.protobuf.Empty"
+	if empty {
+		g.P("if _, err := c.rpc.DoContext(ctx, ", rpcPackage.Ident("Call"), "{")
+	} else {
+		g.P("resp, err := c.rpc.DoContext(ctx, ", rpcPackage.Ident("Call"), "{")
+	}
+	g.P("ID: ", rpcIDConst(m), ",")
+	g.P("Args: args,")
+	if nbField != nil {
+		g.P("NotebookID: in.Get", nbField.GoName, "(),")
+	}
+	if empty {
+		g.P("}); err != nil {")
+	} else {
+		g.P("})")
+		g.P("if err != nil {")
+	}
+	g.P("return nil, err")
+	g.P("}")
+	if empty {
+		g.P("return new(", m.Output.GoIdent, "), nil")
+	} else {
+		g.P("out := new(", m.Output.GoIdent, ")")
+		g.P("if err := ", beprotojsonPackage.Ident("Unmarshal"), "(resp, out); err != nil {")
+		g.P("return nil, ", fmtPackage.Ident("Errorf"), `("parse response: %w", err)`)
+		g.P("}")
+		g.P("return out, nil")
+	}
+	g.P("}")
+	g.P()
+	return nil
+}
+
+func rpcIDConst(m *protogen.Method) string {
+	return m.Parent.GoName + "_" + m.GoName + "_RPCID"
+}
+
+func rpcID(m *protogen.Method) (string, error) {
+	id, _ := option(m.Desc.Options(), rpcIDOption).Interface().(string)
+	if id == "" {
+		return "", fmt.Errorf("%s: missing (rpc_id) option", m.Desc.FullName())
+	}
+	return id, nil
+}
+
+// notebookIDField returns the request field marked with (notebook_id), if any.
+func notebookIDField(msg *protogen.Message) (*protogen.Field, error) {
+	var found *protogen.Field
+	for _, f := range msg.Fields {
+		if marked, _ := option(f.Desc.Options(), notebookIDOption).Interface().(bool); !marked {
+			continue
+		}
+		if f.Desc.Kind() != protoreflect.StringKind || f.Desc.IsList() {
+			return nil, fmt.Errorf("%s: (notebook_id) field must be a singular string", f.Desc.FullName())
+		}
+		if found != nil {
+			return nil, fmt.Errorf("%s: more than one (notebook_id) field", msg.Desc.FullName())
+		}
+		found = f
+	}
+	return found, nil
+}
+
+// option returns the value of the named extension in opts, or an invalid
+// value if it is unset or unknown. Without the generated Go types linked in,
+// extensions arrive as unknown fields, so opts is re-parsed with the
+// extensions from the request.
+func option(opts proto.Message, name protoreflect.FullName) protoreflect.Value {
+	xt, err := extensions.FindExtensionByName(name)
+	if err != nil {
+		return protoreflect.Value{}
+	}
+	b, err := proto.Marshal(opts)
+	if err != nil {
+		return protoreflect.Value{}
+	}
+	m := opts.ProtoReflect().New()
+	if err := (proto.UnmarshalOptions{Resolver: extensions}).Unmarshal(b, m.Interface()); err != nil {
+		return protoreflect.Value{}
+	}
+	if !m.Has(xt.TypeDescriptor()) {
+		return protoreflect.Value{}
+	}
+	return m.Get(xt.TypeDescriptor())
+}
@@ -11,6 +11,7 @@ package notebooklmv1alpha1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
@@ -24,6 +25,166 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ConversationalGoal int32
+
+const (
+	ConversationalGoal_CONVERSATIONAL_GOAL_UNSPECIFIED    ConversationalGoal = 0
+	ConversationalGoal_CONVERSATIONAL_GOAL_DEFAULT        ConversationalGoal = 1 // general research and brainstorming
+	ConversationalGoal_CONVERSATIONAL_GOAL_LEARNING_GUIDE ConversationalGoal = 2 // guides the user through the material
+	ConversationalGoal_CONVERSATIONAL_GOAL_CUSTOM         ConversationalGoal = 3 // follows custom_prompt
+)
+
+// Enum value maps for ConversationalGoal.
+var (
+	ConversationalGoal_name = map[int32]string{
+		0: "CONVERSATIONAL_GOAL_UNSPECIFIED",
+		1: "CONVERSATIONAL_GOAL_DEFAULT",
+		2: "CONVERSATIONAL_GOAL_LEARNING_GUIDE",
+		3: "CONVERSATIONAL_GOAL_CUSTOM",
+	}
+	ConversationalGoal_value = map[string]int32{
+		"CONVERSATIONAL_GOAL_UNSPECIFIED":    0,
+		"CONVERSATIONAL_GOAL_DEFAULT":        1,
+		"CONVERSATIONAL_GOAL_LEARNING_GUIDE": 2,
+		"CONVERSATIONAL_GOAL_CUSTOM":         3,
+	}
+)
+
+func (x ConversationalGoal) Enum() *ConversationalGoal {
+	p := new(ConversationalGoal)
+	*p = x
+	return p
+}
+
+func (x ConversationalGoal) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConversationalGoal) Descriptor() protoreflect.EnumDescriptor {
+	return file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[0].Descriptor()
+}
+
+func (ConversationalGoal) Type() protoreflect.EnumType {
+	return &file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[0]
+}
+
+func (x ConversationalGoal) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConversationalGoal.Descriptor instead.
+func (ConversationalGoal) EnumDescriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{0}
+}
+
+type ResponseLength int32
+
+const (
+	ResponseLength_RESPONSE_LENGTH_UNSPECIFIED ResponseLength = 0
+	ResponseLength_RESPONSE_LENGTH_DEFAULT     ResponseLength = 1
+	ResponseLength_RESPONSE_LENGTH_LONGER      ResponseLength = 2
+	ResponseLength_RESPONSE_LENGTH_SHORTER     ResponseLength = 3
+)
+
+// Enum value maps for ResponseLength.
+var (
+	ResponseLength_name = map[int32]string{
+		0: "RESPONSE_LENGTH_UNSPECIFIED",
+		1: "RESPONSE_LENGTH_DEFAULT",
+		2: "RESPONSE_LENGTH_LONGER",
+		3: "RESPONSE_LENGTH_SHORTER",
+	}
+	ResponseLength_value = map[string]int32{
+		"RESPONSE_LENGTH_UNSPECIFIED": 0,
+		"RESPONSE_LENGTH_DEFAULT":     1,
+		"RESPONSE_LENGTH_LONGER":      2,
+		"RESPONSE_LENGTH_SHORTER":     3,
+	}
+)
+
+func (x ResponseLength) Enum() *ResponseLength {
+	p := new(ResponseLength)
+	*p = x
+	return p
+}
+
+func (x ResponseLength) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResponseLength) Descriptor() protoreflect.EnumDescriptor {
+	return file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[1].Descriptor()
+}
+
+func (ResponseLength) Type() protoreflect.EnumType {
+	return &file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[1]
+}
+
+func (x ResponseLength) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResponseLength.Descriptor instead.
+func (ResponseLength) EnumDescriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{1}
+}
+
+// ProjectRole is a user's role on a notebook.
+type ProjectRole int32
+
+const (
+	ProjectRole_PROJECT_ROLE_UNSPECIFIED ProjectRole = 0
+	ProjectRole_PROJECT_ROLE_OWNER       ProjectRole = 1
+	ProjectRole_PROJECT_ROLE_EDITOR      ProjectRole = 2
+	ProjectRole_PROJECT_ROLE_VIEWER      ProjectRole = 3
+	ProjectRole_PROJECT_ROLE_REMOVED     ProjectRole = 4 // in ShareProject requests, revokes access
+)
+
+// Enum value maps for ProjectRole.
+var (
+	ProjectRole_name = map[int32]string{
+		0: "PROJECT_ROLE_UNSPECIFIED",
+		1: "PROJECT_ROLE_OWNER",
+		2: "PROJECT_ROLE_EDITOR",
+		3: "PROJECT_ROLE_VIEWER",
+		4: "PROJECT_ROLE_REMOVED",
+	}
+	ProjectRole_value = map[string]int32{
+		"PROJECT_ROLE_UNSPECIFIED": 0,
+		"PROJECT_ROLE_OWNER":       1,
+		"PROJECT_ROLE_EDITOR":      2,
+		"PROJECT_ROLE_VIEWER":      3,
+		"PROJECT_ROLE_REMOVED":     4,
+	}
+)
+
+func (x ProjectRole) Enum() *ProjectRole {
+	p := new(ProjectRole)
+	*p = x
+	return p
+}
+
+func (x ProjectRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[2].Descriptor()
+}
+
+func (ProjectRole) Type() protoreflect.EnumType {
+	return &file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[2]
+}
+
+func (x ProjectRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectRole.Descriptor instead.
+func (ProjectRole) EnumDescriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{2}
+}
+
 type SourceType int32
 
 const (
@@ -75,11 +236,11 @@ func (x SourceType) String() string {
 }
 
 func (SourceType) Descriptor() protoreflect.EnumDescriptor {
-	return file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[0].Descriptor()
+	return file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[3].Descriptor()
 }
 
 func (SourceType) Type() protoreflect.EnumType {
-	return &file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[0]
+	return &file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[3]
 }
 
 func (x SourceType) Number() protoreflect.EnumNumber {
@@ -88,7 +249,260 @@ func (x SourceType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SourceType.Descriptor instead.
 func (SourceType) EnumDescriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{0}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{3}
+}
+
+type ChatRole int32
+
+const (
+	ChatRole_CHAT_ROLE_UNSPECIFIED ChatRole = 0
+	ChatRole_CHAT_ROLE_USER        ChatRole = 1
+	ChatRole_CHAT_ROLE_MODEL       ChatRole = 2
+)
+
+// Enum value maps for ChatRole.
+var (
+	ChatRole_name = map[int32]string{
+		0: "CHAT_ROLE_UNSPECIFIED",
+		1: "CHAT_ROLE_USER",
+		2: "CHAT_ROLE_MODEL",
+	}
+	ChatRole_value = map[string]int32{
+		"CHAT_ROLE_UNSPECIFIED": 0,
+		"CHAT_ROLE_USER":        1,
+		"CHAT_ROLE_MODEL":       2,
+	}
+)
+
+func (x ChatRole) Enum() *ChatRole {
+	p := new(ChatRole)
+	*p = x
+	return p
+}
+
+func (x ChatRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[4].Descriptor()
+}
+
+func (ChatRole) Type() protoreflect.EnumType {
+	return &file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[4]
+}
+
+func (x ChatRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatRole.Descriptor instead.
+func (ChatRole) EnumDescriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{4}
+}
+
+// ProjectAccess controls who can open a notebook through its share link.
+type ProjectAccess int32
+
+const (
+	ProjectAccess_PROJECT_ACCESS_UNSPECIFIED      ProjectAccess = 0
+	ProjectAccess_PROJECT_ACCESS_RESTRICTED       ProjectAccess = 1 // only the owner and collaborators
+	ProjectAccess_PROJECT_ACCESS_ANYONE_WITH_LINK ProjectAccess = 2
+)
+
+// Enum value maps for ProjectAccess.
+var (
+	ProjectAccess_name = map[int32]string{
+		0: "PROJECT_ACCESS_UNSPECIFIED",
+		1: "PROJECT_ACCESS_RESTRICTED",
+		2: "PROJECT_ACCESS_ANYONE_WITH_LINK",
+	}
+	ProjectAccess_value = map[string]int32{
+		"PROJECT_ACCESS_UNSPECIFIED":      0,
+		"PROJECT_ACCESS_RESTRICTED":       1,
+		"PROJECT_ACCESS_ANYONE_WITH_LINK": 2,
+	}
+)
+
+func (x ProjectAccess) Enum() *ProjectAccess {
+	p := new(ProjectAccess)
+	*p = x
+	return p
+}
+
+func (x ProjectAccess) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectAccess) Descriptor() protoreflect.EnumDescriptor {
+	return file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[5].Descriptor()
+}
+
+func (ProjectAccess) Type() protoreflect.EnumType {
+	return &file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[5]
+}
+
+func (x ProjectAccess) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectAccess.Descriptor instead.
+func (ProjectAccess) EnumDescriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{5}
+}
+
+type AccountPlan int32
+
+const (
+	AccountPlan_ACCOUNT_PLAN_UNSPECIFIED AccountPlan = 0
+	AccountPlan_ACCOUNT_PLAN_STANDARD    AccountPlan = 1
+	AccountPlan_ACCOUNT_PLAN_PLUS        AccountPlan = 2
+)
+
+// Enum value maps for AccountPlan.
+var (
+	AccountPlan_name = map[int32]string{
+		0: "ACCOUNT_PLAN_UNSPECIFIED",
+		1: "ACCOUNT_PLAN_STANDARD",
+		2: "ACCOUNT_PLAN_PLUS",
+	}
+	AccountPlan_value = map[string]int32{
+		"ACCOUNT_PLAN_UNSPECIFIED": 0,
+		"ACCOUNT_PLAN_STANDARD":    1,
+		"ACCOUNT_PLAN_PLUS":        2,
+	}
+)
+
+func (x AccountPlan) Enum() *AccountPlan {
+	p := new(AccountPlan)
+	*p = x
+	return p
+}
+
+func (x AccountPlan) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountPlan) Descriptor() protoreflect.EnumDescriptor {
+	return file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[6].Descriptor()
+}
+
+func (AccountPlan) Type() protoreflect.EnumType {
+	return &file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[6]
+}
+
+func (x AccountPlan) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountPlan.Descriptor instead.
+func (AccountPlan) EnumDescriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{6}
+}
+
+type GuidebookStatus int32
+
+const (
+	GuidebookStatus_GUIDEBOOK_STATUS_UNSPECIFIED GuidebookStatus = 0
+	GuidebookStatus_GUIDEBOOK_STATUS_DRAFT       GuidebookStatus = 1
+	GuidebookStatus_GUIDEBOOK_STATUS_PUBLISHED   GuidebookStatus = 2
+)
+
+// Enum value maps for GuidebookStatus.
+var (
+	GuidebookStatus_name = map[int32]string{
+		0: "GUIDEBOOK_STATUS_UNSPECIFIED",
+		1: "GUIDEBOOK_STATUS_DRAFT",
+		2: "GUIDEBOOK_STATUS_PUBLISHED",
+	}
+	GuidebookStatus_value = map[string]int32{
+		"GUIDEBOOK_STATUS_UNSPECIFIED": 0,
+		"GUIDEBOOK_STATUS_DRAFT":       1,
+		"GUIDEBOOK_STATUS_PUBLISHED":   2,
+	}
+)
+
+func (x GuidebookStatus) Enum() *GuidebookStatus {
+	p := new(GuidebookStatus)
+	*p = x
+	return p
+}
+
+func (x GuidebookStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GuidebookStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[7].Descriptor()
+}
+
+func (GuidebookStatus) Type() protoreflect.EnumType {
+	return &file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[7]
+}
+
+func (x GuidebookStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GuidebookStatus.Descriptor instead.
+func (GuidebookStatus) EnumDescriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{7}
+}
+
+// ArtifactType is a kind of study document NotebookLM can generate.
+type ArtifactType int32
+
+const (
+	ArtifactType_ARTIFACT_TYPE_UNSPECIFIED  ArtifactType = 0
+	ArtifactType_ARTIFACT_TYPE_FAQ          ArtifactType = 1
+	ArtifactType_ARTIFACT_TYPE_STUDY_GUIDE  ArtifactType = 2
+	ArtifactType_ARTIFACT_TYPE_BRIEFING_DOC ArtifactType = 3
+	ArtifactType_ARTIFACT_TYPE_TIMELINE     ArtifactType = 4
+)
+
+// Enum value maps for ArtifactType.
+var (
+	ArtifactType_name = map[int32]string{
+		0: "ARTIFACT_TYPE_UNSPECIFIED",
+		1: "ARTIFACT_TYPE_FAQ",
+		2: "ARTIFACT_TYPE_STUDY_GUIDE",
+		3: "ARTIFACT_TYPE_BRIEFING_DOC",
+		4: "ARTIFACT_TYPE_TIMELINE",
+	}
+	ArtifactType_value = map[string]int32{
+		"ARTIFACT_TYPE_UNSPECIFIED":  0,
+		"ARTIFACT_TYPE_FAQ":          1,
+		"ARTIFACT_TYPE_STUDY_GUIDE":  2,
+		"ARTIFACT_TYPE_BRIEFING_DOC": 3,
+		"ARTIFACT_TYPE_TIMELINE":     4,
+	}
+)
+
+func (x ArtifactType) Enum() *ArtifactType {
+	p := new(ArtifactType)
+	*p = x
+	return p
+}
+
+func (x ArtifactType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArtifactType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[8].Descriptor()
+}
+
+func (ArtifactType) Type() protoreflect.EnumType {
+	return &file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[8]
+}
+
+func (x ArtifactType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArtifactType.Descriptor instead.
+func (ArtifactType) EnumDescriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{8}
 }
 
 type SourceSettings_SourceStatus int32
@@ -127,11 +541,11 @@ func (x SourceSettings_SourceStatus) String() string {
 }
 
 func (SourceSettings_SourceStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[1].Descriptor()
+	return file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[9].Descriptor()
 }
 
 func (SourceSettings_SourceStatus) Type() protoreflect.EnumType {
-	return &file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[1]
+	return &file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[9]
 }
 
 func (x SourceSettings_SourceStatus) Number() protoreflect.EnumNumber {
@@ -140,7 +554,7 @@ func (x SourceSettings_SourceStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SourceSettings_SourceStatus.Descriptor instead.
 func (SourceSettings_SourceStatus) EnumDescriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{7, 0}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{9, 0}
 }
 
 type SourceIssue_Reason int32
@@ -215,11 +629,11 @@ func (x SourceIssue_Reason) String() string {
 }
 
 func (SourceIssue_Reason) Descriptor() protoreflect.EnumDescriptor {
-	return file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[2].Descriptor()
+	return file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[10].Descriptor()
 }
 
 func (SourceIssue_Reason) Type() protoreflect.EnumType {
-	return &file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[2]
+	return &file_notebooklm_v1alpha1_notebooklm_proto_enumTypes[10]
 }
 
 func (x SourceIssue_Reason) Number() protoreflect.EnumNumber {
@@ -228,7 +642,7 @@ func (x SourceIssue_Reason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SourceIssue_Reason.Descriptor instead.
 func (SourceIssue_Reason) EnumDescriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{8, 0}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{10, 0}
 }
 
 type Project struct {
@@ -241,6 +655,11 @@ type Project struct {
 	ProjectId string           `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	Emoji     string           `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji,omitempty"`
 	Metadata  *ProjectMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// metadata is at 6 in the captured GetProject response in beprotojson_test,
+	// so the fields below cannot be at 6 to 9 as first guessed. Their own
+	// positions are not confirmed by a capture yet.
+	Config           *ChatbotConfig    `protobuf:"bytes,7,opt,name=config,proto3" json:"config,omitempty"`
+	AdvancedSettings *AdvancedSettings `protobuf:"bytes,8,opt,name=advanced_settings,json=advancedSettings,proto3" json:"advanced_settings,omitempty"`
 }
 
 func (x *Project) Reset() {
@@ -310,24 +729,33 @@ func (x *Project) GetMetadata() *ProjectMetadata {
 	return nil
 }
 
-type ProjectMetadata struct {
+func (x *Project) GetConfig() *ChatbotConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Project) GetAdvancedSettings() *AdvancedSettings {
+	if x != nil {
+		return x.AdvancedSettings
+	}
+	return nil
+}
+
+// ChatbotConfig configures how chat answers questions about a notebook.
+type ChatbotConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserRole      int32 `protobuf:"varint,1,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`
-	SessionActive bool  `protobuf:"varint,2,opt,name=session_active,json=sessionActive,proto3" json:"session_active,omitempty"` // or similar
-	// bool something = 3;
-	// field 4 reserved/unknown
-	// field 5 reserved/unknown
-	CreateTime   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	ModifiedTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=modified_time,json=modifiedTime,proto3" json:"modified_time,omitempty"` // or similar
-	Type         int32                  `protobuf:"varint,7,opt,name=type,proto3" json:"type,omitempty"`
-	IsStarred    bool                   `protobuf:"varint,8,opt,name=is_starred,json=isStarred,proto3" json:"is_starred,omitempty"`
+	Goal           ConversationalGoal `protobuf:"varint,1,opt,name=goal,proto3,enum=notebooklm.v1alpha1.ConversationalGoal" json:"goal,omitempty"`
+	CustomPrompt   string             `protobuf:"bytes,2,opt,name=custom_prompt,json=customPrompt,proto3" json:"custom_prompt,omitempty"` // used with CONVERSATIONAL_GOAL_CUSTOM
+	ResponseLength ResponseLength     `protobuf:"varint,3,opt,name=response_length,json=responseLength,proto3,enum=notebooklm.v1alpha1.ResponseLength" json:"response_length,omitempty"`
 }
 
-func (x *ProjectMetadata) Reset() {
-	*x = ProjectMetadata{}
+func (x *ChatbotConfig) Reset() {
+	*x = ChatbotConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -335,13 +763,13 @@ func (x *ProjectMetadata) Reset() {
 	}
 }
 
-func (x *ProjectMetadata) String() string {
+func (x *ChatbotConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectMetadata) ProtoMessage() {}
+func (*ChatbotConfig) ProtoMessage() {}
 
-func (x *ProjectMetadata) ProtoReflect() protoreflect.Message {
+func (x *ChatbotConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -353,33 +781,151 @@ func (x *ProjectMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectMetadata.ProtoReflect.Descriptor instead.
-func (*ProjectMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChatbotConfig.ProtoReflect.Descriptor instead.
+func (*ChatbotConfig) Descriptor() ([]byte, []int) {
 	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ProjectMetadata) GetUserRole() int32 {
+func (x *ChatbotConfig) GetGoal() ConversationalGoal {
 	if x != nil {
-		return x.UserRole
+		return x.Goal
 	}
-	return 0
+	return ConversationalGoal_CONVERSATIONAL_GOAL_UNSPECIFIED
 }
 
-func (x *ProjectMetadata) GetSessionActive() bool {
+func (x *ChatbotConfig) GetCustomPrompt() string {
 	if x != nil {
-		return x.SessionActive
+		return x.CustomPrompt
 	}
-	return false
+	return ""
 }
 
-func (x *ProjectMetadata) GetCreateTime() *timestamppb.Timestamp {
+func (x *ChatbotConfig) GetResponseLength() ResponseLength {
 	if x != nil {
-		return x.CreateTime
+		return x.ResponseLength
 	}
-	return nil
+	return ResponseLength_RESPONSE_LENGTH_UNSPECIFIED
 }
 
-func (x *ProjectMetadata) GetModifiedTime() *timestamppb.Timestamp {
+// AdvancedSettings holds the less common per-notebook chat settings.
+type AdvancedSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// BCP 47 language of answers, e.g. "de". Unset follows the account.
+	OutputLanguage *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=output_language,json=outputLanguage,proto3" json:"output_language,omitempty"`
+}
+
+func (x *AdvancedSettings) Reset() {
+	*x = AdvancedSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdvancedSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvancedSettings) ProtoMessage() {}
+
+func (x *AdvancedSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvancedSettings.ProtoReflect.Descriptor instead.
+func (*AdvancedSettings) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AdvancedSettings) GetOutputLanguage() *wrapperspb.StringValue {
+	if x != nil {
+		return x.OutputLanguage
+	}
+	return nil
+}
+
+type ProjectMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserRole      ProjectRole `protobuf:"varint,1,opt,name=user_role,json=userRole,proto3,enum=notebooklm.v1alpha1.ProjectRole" json:"user_role,omitempty"`
+	SessionActive bool        `protobuf:"varint,2,opt,name=session_active,json=sessionActive,proto3" json:"session_active,omitempty"` // or similar
+	// bool something = 3;
+	// field 4 reserved/unknown
+	// field 5 reserved/unknown
+	CreateTime   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	ModifiedTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=modified_time,json=modifiedTime,proto3" json:"modified_time,omitempty"` // or similar
+	Type         int32                  `protobuf:"varint,7,opt,name=type,proto3" json:"type,omitempty"`
+	IsStarred    *bool                  `protobuf:"varint,8,opt,name=is_starred,json=isStarred,proto3,oneof" json:"is_starred,omitempty"` // optional so updates can unstar
+}
+
+func (x *ProjectMetadata) Reset() {
+	*x = ProjectMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectMetadata) ProtoMessage() {}
+
+func (x *ProjectMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectMetadata.ProtoReflect.Descriptor instead.
+func (*ProjectMetadata) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProjectMetadata) GetUserRole() ProjectRole {
+	if x != nil {
+		return x.UserRole
+	}
+	return ProjectRole_PROJECT_ROLE_UNSPECIFIED
+}
+
+func (x *ProjectMetadata) GetSessionActive() bool {
+	if x != nil {
+		return x.SessionActive
+	}
+	return false
+}
+
+func (x *ProjectMetadata) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ProjectMetadata) GetModifiedTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.ModifiedTime
 	}
@@ -394,8 +940,8 @@ func (x *ProjectMetadata) GetType() int32 {
 }
 
 func (x *ProjectMetadata) GetIsStarred() bool {
-	if x != nil {
-		return x.IsStarred
+	if x != nil && x.IsStarred != nil {
+		return *x.IsStarred
 	}
 	return false
 }
@@ -411,7 +957,7 @@ type SourceId struct {
 func (x *SourceId) Reset() {
 	*x = SourceId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[2]
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -424,7 +970,7 @@ func (x *SourceId) String() string {
 func (*SourceId) ProtoMessage() {}
 
 func (x *SourceId) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[2]
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,7 +983,7 @@ func (x *SourceId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SourceId.ProtoReflect.Descriptor instead.
 func (*SourceId) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{2}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SourceId) GetSourceId() string {
@@ -462,7 +1008,7 @@ type Source struct {
 func (x *Source) Reset() {
 	*x = Source{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[3]
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -475,7 +1021,7 @@ func (x *Source) String() string {
 func (*Source) ProtoMessage() {}
 
 func (x *Source) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[3]
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -488,7 +1034,7 @@ func (x *Source) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Source.ProtoReflect.Descriptor instead.
 func (*Source) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{3}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Source) GetSourceId() *SourceId {
@@ -532,7 +1078,6 @@ type SourceMetadata struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to MetadataType:
-	//
 	//	*SourceMetadata_GoogleDocs
 	//	*SourceMetadata_Youtube
 	MetadataType          isSourceMetadata_MetadataType `protobuf_oneof:"metadata_type"`
@@ -545,7 +1090,7 @@ type SourceMetadata struct {
 func (x *SourceMetadata) Reset() {
 	*x = SourceMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[4]
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -558,7 +1103,7 @@ func (x *SourceMetadata) String() string {
 func (*SourceMetadata) ProtoMessage() {}
 
 func (x *SourceMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[4]
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -571,7 +1116,7 @@ func (x *SourceMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SourceMetadata.ProtoReflect.Descriptor instead.
 func (*SourceMetadata) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{4}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{6}
 }
 
 func (m *SourceMetadata) GetMetadataType() isSourceMetadata_MetadataType {
@@ -643,7 +1188,7 @@ type GoogleDocsSourceMetadata struct {
 func (x *GoogleDocsSourceMetadata) Reset() {
 	*x = GoogleDocsSourceMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[5]
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -656,7 +1201,7 @@ func (x *GoogleDocsSourceMetadata) String() string {
 func (*GoogleDocsSourceMetadata) ProtoMessage() {}
 
 func (x *GoogleDocsSourceMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[5]
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +1214,7 @@ func (x *GoogleDocsSourceMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoogleDocsSourceMetadata.ProtoReflect.Descriptor instead.
 func (*GoogleDocsSourceMetadata) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{5}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GoogleDocsSourceMetadata) GetDocumentId() string {
@@ -691,7 +1236,7 @@ type YoutubeSourceMetadata struct {
 func (x *YoutubeSourceMetadata) Reset() {
 	*x = YoutubeSourceMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[6]
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -704,7 +1249,7 @@ func (x *YoutubeSourceMetadata) String() string {
 func (*YoutubeSourceMetadata) ProtoMessage() {}
 
 func (x *YoutubeSourceMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[6]
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,7 +1262,7 @@ func (x *YoutubeSourceMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use YoutubeSourceMetadata.ProtoReflect.Descriptor instead.
 func (*YoutubeSourceMetadata) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{6}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *YoutubeSourceMetadata) GetYoutubeUrl() string {
@@ -745,7 +1290,7 @@ type SourceSettings struct {
 func (x *SourceSettings) Reset() {
 	*x = SourceSettings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[7]
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -758,7 +1303,7 @@ func (x *SourceSettings) String() string {
 func (*SourceSettings) ProtoMessage() {}
 
 func (x *SourceSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[7]
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,7 +1316,7 @@ func (x *SourceSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SourceSettings.ProtoReflect.Descriptor instead.
 func (*SourceSettings) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{7}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SourceSettings) GetStatus() SourceSettings_SourceStatus {
@@ -792,7 +1337,7 @@ type SourceIssue struct {
 func (x *SourceIssue) Reset() {
 	*x = SourceIssue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[8]
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -805,7 +1350,7 @@ func (x *SourceIssue) String() string {
 func (*SourceIssue) ProtoMessage() {}
 
 func (x *SourceIssue) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[8]
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -818,7 +1363,7 @@ func (x *SourceIssue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SourceIssue.ProtoReflect.Descriptor instead.
 func (*SourceIssue) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{8}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SourceIssue) GetReason() SourceIssue_Reason {
@@ -839,7 +1384,7 @@ type GetNotesResponse struct {
 func (x *GetNotesResponse) Reset() {
 	*x = GetNotesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[9]
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -852,7 +1397,7 @@ func (x *GetNotesResponse) String() string {
 func (*GetNotesResponse) ProtoMessage() {}
 
 func (x *GetNotesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[9]
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -865,7 +1410,7 @@ func (x *GetNotesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNotesResponse.ProtoReflect.Descriptor instead.
 func (*GetNotesResponse) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{9}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetNotesResponse) GetNotes() []*Source {
@@ -875,20 +1420,70 @@ func (x *GetNotesResponse) GetNotes() []*Source {
 	return nil
 }
 
+// AudioOverviewResponse is returned as [null,null,[...],null,[false]].
+type AudioOverviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AudioOverview *AudioOverview `protobuf:"bytes,3,opt,name=audio_overview,json=audioOverview,proto3" json:"audio_overview,omitempty"`
+}
+
+func (x *AudioOverviewResponse) Reset() {
+	*x = AudioOverviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AudioOverviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudioOverviewResponse) ProtoMessage() {}
+
+func (x *AudioOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudioOverviewResponse.ProtoReflect.Descriptor instead.
+func (*AudioOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AudioOverviewResponse) GetAudioOverview() *AudioOverview {
+	if x != nil {
+		return x.AudioOverview
+	}
+	return nil
+}
+
 type AudioOverview struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status       string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Content      string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Instructions string `protobuf:"bytes,3,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	Status  int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"` // base64 encoded audio
+	AudioId string `protobuf:"bytes,3,opt,name=audio_id,json=audioId,proto3" json:"audio_id,omitempty"`
+	Title   string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	IsReady bool   `protobuf:"varint,6,opt,name=is_ready,json=isReady,proto3" json:"is_ready,omitempty"`
 }
 
 func (x *AudioOverview) Reset() {
 	*x = AudioOverview{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[10]
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -901,7 +1496,7 @@ func (x *AudioOverview) String() string {
 func (*AudioOverview) ProtoMessage() {}
 
 func (x *AudioOverview) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[10]
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -914,14 +1509,14 @@ func (x *AudioOverview) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AudioOverview.ProtoReflect.Descriptor instead.
 func (*AudioOverview) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{10}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *AudioOverview) GetStatus() string {
+func (x *AudioOverview) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
-	return ""
+	return 0
 }
 
 func (x *AudioOverview) GetContent() string {
@@ -931,13 +1526,27 @@ func (x *AudioOverview) GetContent() string {
 	return ""
 }
 
-func (x *AudioOverview) GetInstructions() string {
+func (x *AudioOverview) GetAudioId() string {
 	if x != nil {
-		return x.Instructions
+		return x.AudioId
+	}
+	return ""
+}
+
+func (x *AudioOverview) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
+func (x *AudioOverview) GetIsReady() bool {
+	if x != nil {
+		return x.IsReady
+	}
+	return false
+}
+
 type GenerateDocumentGuidesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -949,7 +1558,7 @@ type GenerateDocumentGuidesResponse struct {
 func (x *GenerateDocumentGuidesResponse) Reset() {
 	*x = GenerateDocumentGuidesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[11]
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -962,7 +1571,7 @@ func (x *GenerateDocumentGuidesResponse) String() string {
 func (*GenerateDocumentGuidesResponse) ProtoMessage() {}
 
 func (x *GenerateDocumentGuidesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[11]
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,7 +1584,7 @@ func (x *GenerateDocumentGuidesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateDocumentGuidesResponse.ProtoReflect.Descriptor instead.
 func (*GenerateDocumentGuidesResponse) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{11}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GenerateDocumentGuidesResponse) GetGuides() []*DocumentGuide {
@@ -985,18 +1594,21 @@ func (x *GenerateDocumentGuidesResponse) GetGuides() []*DocumentGuide {
 	return nil
 }
 
+// DocumentGuide summarizes a single source.
 type DocumentGuide struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Content   string    `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"` // summary
+	KeyTopics []string  `protobuf:"bytes,2,rep,name=key_topics,json=keyTopics,proto3" json:"key_topics,omitempty"`
+	SourceId  *SourceId `protobuf:"bytes,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
 }
 
 func (x *DocumentGuide) Reset() {
 	*x = DocumentGuide{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[12]
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1009,7 +1621,7 @@ func (x *DocumentGuide) String() string {
 func (*DocumentGuide) ProtoMessage() {}
 
 func (x *DocumentGuide) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[12]
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,7 +1634,7 @@ func (x *DocumentGuide) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DocumentGuide.ProtoReflect.Descriptor instead.
 func (*DocumentGuide) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{12}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DocumentGuide) GetContent() string {
@@ -1032,18 +1644,34 @@ func (x *DocumentGuide) GetContent() string {
 	return ""
 }
 
+func (x *DocumentGuide) GetKeyTopics() []string {
+	if x != nil {
+		return x.KeyTopics
+	}
+	return nil
+}
+
+func (x *DocumentGuide) GetSourceId() *SourceId {
+	if x != nil {
+		return x.SourceId
+	}
+	return nil
+}
+
 type GenerateNotebookGuideResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Content            string               `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"` // the whole guide
+	Summary            string               `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	SuggestedQuestions []*SuggestedQuestion `protobuf:"bytes,3,rep,name=suggested_questions,json=suggestedQuestions,proto3" json:"suggested_questions,omitempty"`
 }
 
 func (x *GenerateNotebookGuideResponse) Reset() {
 	*x = GenerateNotebookGuideResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[13]
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1056,7 +1684,7 @@ func (x *GenerateNotebookGuideResponse) String() string {
 func (*GenerateNotebookGuideResponse) ProtoMessage() {}
 
 func (x *GenerateNotebookGuideResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[13]
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,7 +1697,7 @@ func (x *GenerateNotebookGuideResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateNotebookGuideResponse.ProtoReflect.Descriptor instead.
 func (*GenerateNotebookGuideResponse) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{13}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GenerateNotebookGuideResponse) GetContent() string {
@@ -1079,31 +1707,101 @@ func (x *GenerateNotebookGuideResponse) GetContent() string {
 	return ""
 }
 
-type GenerateOutlineResponse struct {
+func (x *GenerateNotebookGuideResponse) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *GenerateNotebookGuideResponse) GetSuggestedQuestions() []*SuggestedQuestion {
+	if x != nil {
+		return x.SuggestedQuestions
+	}
+	return nil
+}
+
+// SuggestedQuestion is a question chat can answer from the notebook's sources.
+type SuggestedQuestion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Question string `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Prompt   string `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"` // sent to chat instead of the question when set
 }
 
-func (x *GenerateOutlineResponse) Reset() {
-	*x = GenerateOutlineResponse{}
+func (x *SuggestedQuestion) Reset() {
+	*x = SuggestedQuestion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[14]
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateOutlineResponse) String() string {
+func (x *SuggestedQuestion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateOutlineResponse) ProtoMessage() {}
+func (*SuggestedQuestion) ProtoMessage() {}
 
-func (x *GenerateOutlineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[14]
+func (x *SuggestedQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestedQuestion.ProtoReflect.Descriptor instead.
+func (*SuggestedQuestion) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SuggestedQuestion) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *SuggestedQuestion) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+type GenerateOutlineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *GenerateOutlineResponse) Reset() {
+	*x = GenerateOutlineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateOutlineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateOutlineResponse) ProtoMessage() {}
+
+func (x *GenerateOutlineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,7 +1814,7 @@ func (x *GenerateOutlineResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateOutlineResponse.ProtoReflect.Descriptor instead.
 func (*GenerateOutlineResponse) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{14}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GenerateOutlineResponse) GetContent() string {
@@ -1137,7 +1835,7 @@ type GenerateSectionResponse struct {
 func (x *GenerateSectionResponse) Reset() {
 	*x = GenerateSectionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[15]
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1150,7 +1848,7 @@ func (x *GenerateSectionResponse) String() string {
 func (*GenerateSectionResponse) ProtoMessage() {}
 
 func (x *GenerateSectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[15]
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1163,7 +1861,7 @@ func (x *GenerateSectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateSectionResponse.ProtoReflect.Descriptor instead.
 func (*GenerateSectionResponse) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{15}
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GenerateSectionResponse) GetContent() string {
@@ -1173,424 +1871,7727 @@ func (x *GenerateSectionResponse) GetContent() string {
 	return ""
 }
 
-type StartDraftResponse struct {
+// GenerateArtifactResponse is a study document generated from a notebook.
+type GenerateArtifactResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Title   string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"` // markdown
 }
 
-func (x *StartDraftResponse) Reset() {
-	*x = StartDraftResponse{}
+func (x *GenerateArtifactResponse) Reset() {
+	*x = GenerateArtifactResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[16]
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartDraftResponse) String() string {
+func (x *GenerateArtifactResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartDraftResponse) ProtoMessage() {}
+func (*GenerateArtifactResponse) ProtoMessage() {}
 
-func (x *StartDraftResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[16]
+func (x *GenerateArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateArtifactResponse.ProtoReflect.Descriptor instead.
+func (*GenerateArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GenerateArtifactResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GenerateArtifactResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type GenerateMindMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Root *MindMapNode `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (x *GenerateMindMapResponse) Reset() {
+	*x = GenerateMindMapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateMindMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateMindMapResponse) ProtoMessage() {}
+
+func (x *GenerateMindMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateMindMapResponse.ProtoReflect.Descriptor instead.
+func (*GenerateMindMapResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GenerateMindMapResponse) GetRoot() *MindMapNode {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+// MindMapNode is a topic of a mind map.
+type MindMapNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label     string         `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Children  []*MindMapNode `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	SourceIds []*SourceId    `protobuf:"bytes,3,rep,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"` // sources that discuss the topic
+}
+
+func (x *MindMapNode) Reset() {
+	*x = MindMapNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MindMapNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MindMapNode) ProtoMessage() {}
+
+func (x *MindMapNode) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MindMapNode.ProtoReflect.Descriptor instead.
+func (*MindMapNode) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MindMapNode) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MindMapNode) GetChildren() []*MindMapNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *MindMapNode) GetSourceIds() []*SourceId {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+type GenerateFlashcardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flashcards []*Flashcard `protobuf:"bytes,1,rep,name=flashcards,proto3" json:"flashcards,omitempty"`
+}
+
+func (x *GenerateFlashcardsResponse) Reset() {
+	*x = GenerateFlashcardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateFlashcardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateFlashcardsResponse) ProtoMessage() {}
+
+func (x *GenerateFlashcardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateFlashcardsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateFlashcardsResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GenerateFlashcardsResponse) GetFlashcards() []*Flashcard {
+	if x != nil {
+		return x.Flashcards
+	}
+	return nil
+}
+
+type Flashcard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Front     string      `protobuf:"bytes,1,opt,name=front,proto3" json:"front,omitempty"` // question or term
+	Back      string      `protobuf:"bytes,2,opt,name=back,proto3" json:"back,omitempty"`   // answer or definition
+	SourceIds []*SourceId `protobuf:"bytes,3,rep,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"`
+}
+
+func (x *Flashcard) Reset() {
+	*x = Flashcard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Flashcard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Flashcard) ProtoMessage() {}
+
+func (x *Flashcard) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Flashcard.ProtoReflect.Descriptor instead.
+func (*Flashcard) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Flashcard) GetFront() string {
+	if x != nil {
+		return x.Front
+	}
+	return ""
+}
+
+func (x *Flashcard) GetBack() string {
+	if x != nil {
+		return x.Back
+	}
+	return ""
+}
+
+func (x *Flashcard) GetSourceIds() []*SourceId {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+type GenerateQuizResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Questions []*QuizQuestion `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+}
+
+func (x *GenerateQuizResponse) Reset() {
+	*x = GenerateQuizResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateQuizResponse) ProtoMessage() {}
+
+func (x *GenerateQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StartDraftResponse.ProtoReflect.Descriptor instead.
-func (*StartDraftResponse) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{16}
-}
-
-type StartSectionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *StartSectionResponse) Reset() {
-	*x = StartSectionResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *StartSectionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*StartSectionResponse) ProtoMessage() {}
-
-func (x *StartSectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateQuizResponse.ProtoReflect.Descriptor instead.
+func (*GenerateQuizResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GenerateQuizResponse) GetQuestions() []*QuizQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+// QuizQuestion is a multiple-choice question.
+type QuizQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Question    string      `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Options     []string    `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	AnswerIndex int32       `protobuf:"varint,3,opt,name=answer_index,json=answerIndex,proto3" json:"answer_index,omitempty"` // index into options of the correct answer
+	Explanation string      `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	SourceIds   []*SourceId `protobuf:"bytes,5,rep,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"`
+}
+
+func (x *QuizQuestion) Reset() {
+	*x = QuizQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuizQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizQuestion) ProtoMessage() {}
+
+func (x *QuizQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizQuestion.ProtoReflect.Descriptor instead.
+func (*QuizQuestion) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *QuizQuestion) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *QuizQuestion) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *QuizQuestion) GetAnswerIndex() int32 {
+	if x != nil {
+		return x.AnswerIndex
+	}
+	return 0
+}
+
+func (x *QuizQuestion) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *QuizQuestion) GetSourceIds() []*SourceId {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+type StartDraftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StartDraftResponse) Reset() {
+	*x = StartDraftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDraftResponse) ProtoMessage() {}
+
+func (x *StartDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDraftResponse.ProtoReflect.Descriptor instead.
+func (*StartDraftResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{27}
+}
+
+type StartSectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StartSectionResponse) Reset() {
+	*x = StartSectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartSectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSectionResponse) ProtoMessage() {}
+
+func (x *StartSectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSectionResponse.ProtoReflect.Descriptor instead.
+func (*StartSectionResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{28}
+}
+
+// ChatResponse is one frame of a streamed chat answer. Every frame carries
+// the whole answer generated so far.
+type ChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Answer *ChatAnswer `protobuf:"bytes,1,opt,name=answer,proto3" json:"answer,omitempty"`
+}
+
+func (x *ChatResponse) Reset() {
+	*x = ChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatResponse) ProtoMessage() {}
+
+func (x *ChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatResponse.ProtoReflect.Descriptor instead.
+func (*ChatResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChatResponse) GetAnswer() *ChatAnswer {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+type ChatAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text           string      `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	ConversationId string      `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Citations      []*Citation `protobuf:"bytes,3,rep,name=citations,proto3" json:"citations,omitempty"`
+	Done           bool        `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"` // set on the last frame
+}
+
+func (x *ChatAnswer) Reset() {
+	*x = ChatAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatAnswer) ProtoMessage() {}
+
+func (x *ChatAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatAnswer.ProtoReflect.Descriptor instead.
+func (*ChatAnswer) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ChatAnswer) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatAnswer) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ChatAnswer) GetCitations() []*Citation {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
+
+func (x *ChatAnswer) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+// Citation links part of an answer to the source passage it is based on.
+type Citation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceId *SourceId `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Quote    string    `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"` // quoted span of the source
+}
+
+func (x *Citation) Reset() {
+	*x = Citation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Citation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Citation) ProtoMessage() {}
+
+func (x *Citation) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Citation.ProtoReflect.Descriptor instead.
+func (*Citation) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Citation) GetSourceId() *SourceId {
+	if x != nil {
+		return x.SourceId
+	}
+	return nil
+}
+
+func (x *Citation) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+// ChatTurn is an earlier question or answer of a conversation.
+type ChatTurn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string   `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Role ChatRole `protobuf:"varint,2,opt,name=role,proto3,enum=notebooklm.v1alpha1.ChatRole" json:"role,omitempty"`
+}
+
+func (x *ChatTurn) Reset() {
+	*x = ChatTurn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatTurn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatTurn) ProtoMessage() {}
+
+func (x *ChatTurn) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatTurn.ProtoReflect.Descriptor instead.
+func (*ChatTurn) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ChatTurn) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatTurn) GetRole() ChatRole {
+	if x != nil {
+		return x.Role
+	}
+	return ChatRole_CHAT_ROLE_UNSPECIFIED
+}
+
+type GetChatHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*ChatMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"` // oldest first
+}
+
+func (x *GetChatHistoryResponse) Reset() {
+	*x = GetChatHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChatHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatHistoryResponse) ProtoMessage() {}
+
+func (x *GetChatHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetChatHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetChatHistoryResponse) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// ChatMessage is a question or answer stored in a notebook's chat history.
+type ChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId      string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Role           ChatRole               `protobuf:"varint,2,opt,name=role,proto3,enum=notebooklm.v1alpha1.ChatRole" json:"role,omitempty"`
+	Text           string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Citations      []*Citation            `protobuf:"bytes,4,rep,name=citations,proto3" json:"citations,omitempty"` // answers only
+	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	ConversationId string                 `protobuf:"bytes,6,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ChatMessage) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetRole() ChatRole {
+	if x != nil {
+		return x.Role
+	}
+	return ChatRole_CHAT_ROLE_UNSPECIFIED
+}
+
+func (x *ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetCitations() []*Citation {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type ListRecentlyViewedProjectsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Projects []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+}
+
+func (x *ListRecentlyViewedProjectsResponse) Reset() {
+	*x = ListRecentlyViewedProjectsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRecentlyViewedProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecentlyViewedProjectsResponse) ProtoMessage() {}
+
+func (x *ListRecentlyViewedProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecentlyViewedProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecentlyViewedProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListRecentlyViewedProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type ShareAudioResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShareInfo *ShareInfo `protobuf:"bytes,1,opt,name=share_info,json=shareInfo,proto3" json:"share_info,omitempty"`
+}
+
+func (x *ShareAudioResponse) Reset() {
+	*x = ShareAudioResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareAudioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareAudioResponse) ProtoMessage() {}
+
+func (x *ShareAudioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareAudioResponse.ProtoReflect.Descriptor instead.
+func (*ShareAudioResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ShareAudioResponse) GetShareInfo() *ShareInfo {
+	if x != nil {
+		return x.ShareInfo
+	}
+	return nil
+}
+
+type ShareInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShareUrl string `protobuf:"bytes,1,opt,name=share_url,json=shareUrl,proto3" json:"share_url,omitempty"`
+	ShareId  string `protobuf:"bytes,2,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+}
+
+func (x *ShareInfo) Reset() {
+	*x = ShareInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareInfo) ProtoMessage() {}
+
+func (x *ShareInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareInfo.ProtoReflect.Descriptor instead.
+func (*ShareInfo) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ShareInfo) GetShareUrl() string {
+	if x != nil {
+		return x.ShareUrl
+	}
+	return ""
+}
+
+func (x *ShareInfo) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
+// ProjectDetails describes how a notebook is shared.
+type ProjectDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId     string          `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Title         string          `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	OwnerEmail    string          `protobuf:"bytes,3,opt,name=owner_email,json=ownerEmail,proto3" json:"owner_email,omitempty"`
+	Access        ProjectAccess   `protobuf:"varint,4,opt,name=access,proto3,enum=notebooklm.v1alpha1.ProjectAccess" json:"access,omitempty"`
+	ShareUrl      string          `protobuf:"bytes,5,opt,name=share_url,json=shareUrl,proto3" json:"share_url,omitempty"`
+	Collaborators []*Collaborator `protobuf:"bytes,6,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+}
+
+func (x *ProjectDetails) Reset() {
+	*x = ProjectDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectDetails) ProtoMessage() {}
+
+func (x *ProjectDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectDetails.ProtoReflect.Descriptor instead.
+func (*ProjectDetails) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ProjectDetails) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ProjectDetails) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ProjectDetails) GetOwnerEmail() string {
+	if x != nil {
+		return x.OwnerEmail
+	}
+	return ""
+}
+
+func (x *ProjectDetails) GetAccess() ProjectAccess {
+	if x != nil {
+		return x.Access
+	}
+	return ProjectAccess_PROJECT_ACCESS_UNSPECIFIED
+}
+
+func (x *ProjectDetails) GetShareUrl() string {
+	if x != nil {
+		return x.ShareUrl
+	}
+	return ""
+}
+
+func (x *ProjectDetails) GetCollaborators() []*Collaborator {
+	if x != nil {
+		return x.Collaborators
+	}
+	return nil
+}
+
+// Collaborator is a person a notebook is shared with.
+type Collaborator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email       string      `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Role        ProjectRole `protobuf:"varint,2,opt,name=role,proto3,enum=notebooklm.v1alpha1.ProjectRole" json:"role,omitempty"`
+	DisplayName string      `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+}
+
+func (x *Collaborator) Reset() {
+	*x = Collaborator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Collaborator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collaborator) ProtoMessage() {}
+
+func (x *Collaborator) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collaborator.ProtoReflect.Descriptor instead.
+func (*Collaborator) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Collaborator) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Collaborator) GetRole() ProjectRole {
+	if x != nil {
+		return x.Role
+	}
+	return ProjectRole_PROJECT_ROLE_UNSPECIFIED
+}
+
+func (x *Collaborator) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type ShareProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShareUrl string        `protobuf:"bytes,1,opt,name=share_url,json=shareUrl,proto3" json:"share_url,omitempty"`
+	Access   ProjectAccess `protobuf:"varint,2,opt,name=access,proto3,enum=notebooklm.v1alpha1.ProjectAccess" json:"access,omitempty"`
+}
+
+func (x *ShareProjectResponse) Reset() {
+	*x = ShareProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareProjectResponse) ProtoMessage() {}
+
+func (x *ShareProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareProjectResponse.ProtoReflect.Descriptor instead.
+func (*ShareProjectResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ShareProjectResponse) GetShareUrl() string {
+	if x != nil {
+		return x.ShareUrl
+	}
+	return ""
+}
+
+func (x *ShareProjectResponse) GetAccess() ProjectAccess {
+	if x != nil {
+		return x.Access
+	}
+	return ProjectAccess_PROJECT_ACCESS_UNSPECIFIED
+}
+
+type AddSourcesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sources []*Source `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *AddSourcesResponse) Reset() {
+	*x = AddSourcesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSourcesResponse) ProtoMessage() {}
+
+func (x *AddSourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSourcesResponse.ProtoReflect.Descriptor instead.
+func (*AddSourcesResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AddSourcesResponse) GetSources() []*Source {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+// Account describes the signed-in user.
+type Account struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email       string           `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	DisplayName string           `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Plan        AccountPlan      `protobuf:"varint,3,opt,name=plan,proto3,enum=notebooklm.v1alpha1.AccountPlan" json:"plan,omitempty"`
+	Limits      *AccountLimits   `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits,omitempty"`
+	Settings    *AccountSettings `protobuf:"bytes,5,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Account) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Account) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Account) GetPlan() AccountPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return AccountPlan_ACCOUNT_PLAN_UNSPECIFIED
+}
+
+func (x *Account) GetLimits() *AccountLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *Account) GetSettings() *AccountSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// AccountLimits holds the quotas of an account's plan. Limits the server
+// does not report are left unset.
+type AccountLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxNotebooks           *wrapperspb.Int32Value `protobuf:"bytes,1,opt,name=max_notebooks,json=maxNotebooks,proto3" json:"max_notebooks,omitempty"`
+	MaxSourcesPerNotebook  *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=max_sources_per_notebook,json=maxSourcesPerNotebook,proto3" json:"max_sources_per_notebook,omitempty"`
+	MaxDailyAudioOverviews *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=max_daily_audio_overviews,json=maxDailyAudioOverviews,proto3" json:"max_daily_audio_overviews,omitempty"`
+}
+
+func (x *AccountLimits) Reset() {
+	*x = AccountLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountLimits) ProtoMessage() {}
+
+func (x *AccountLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountLimits.ProtoReflect.Descriptor instead.
+func (*AccountLimits) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AccountLimits) GetMaxNotebooks() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxNotebooks
+	}
+	return nil
+}
+
+func (x *AccountLimits) GetMaxSourcesPerNotebook() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxSourcesPerNotebook
+	}
+	return nil
+}
+
+func (x *AccountLimits) GetMaxDailyAudioOverviews() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxDailyAudioOverviews
+	}
+	return nil
+}
+
+// AccountSettings holds account-level preferences. In MutateAccount
+// requests, unset fields are left unchanged.
+type AccountSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OutputLanguage     *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=output_language,json=outputLanguage,proto3" json:"output_language,omitempty"` // BCP-47 code, e.g. "en"
+	EmailNotifications *wrapperspb.BoolValue   `protobuf:"bytes,2,opt,name=email_notifications,json=emailNotifications,proto3" json:"email_notifications,omitempty"`
+}
+
+func (x *AccountSettings) Reset() {
+	*x = AccountSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountSettings) ProtoMessage() {}
+
+func (x *AccountSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountSettings.ProtoReflect.Descriptor instead.
+func (*AccountSettings) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AccountSettings) GetOutputLanguage() *wrapperspb.StringValue {
+	if x != nil {
+		return x.OutputLanguage
+	}
+	return nil
+}
+
+func (x *AccountSettings) GetEmailNotifications() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.EmailNotifications
+	}
+	return nil
+}
+
+// ProjectAnalytics holds usage counts for a notebook. Counts the server does
+// not report for a notebook are left unset.
+type ProjectAnalytics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ViewCount         *wrapperspb.Int32Value `protobuf:"bytes,1,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	UniqueViewerCount *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=unique_viewer_count,json=uniqueViewerCount,proto3" json:"unique_viewer_count,omitempty"`
+	ChatCount         *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=chat_count,json=chatCount,proto3" json:"chat_count,omitempty"`
+	ShareCount        *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
+	LastViewedTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_viewed_time,json=lastViewedTime,proto3" json:"last_viewed_time,omitempty"`
+}
+
+func (x *ProjectAnalytics) Reset() {
+	*x = ProjectAnalytics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectAnalytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectAnalytics) ProtoMessage() {}
+
+func (x *ProjectAnalytics) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectAnalytics.ProtoReflect.Descriptor instead.
+func (*ProjectAnalytics) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ProjectAnalytics) GetViewCount() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ViewCount
+	}
+	return nil
+}
+
+func (x *ProjectAnalytics) GetUniqueViewerCount() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.UniqueViewerCount
+	}
+	return nil
+}
+
+func (x *ProjectAnalytics) GetChatCount() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ChatCount
+	}
+	return nil
+}
+
+func (x *ProjectAnalytics) GetShareCount() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ShareCount
+	}
+	return nil
+}
+
+func (x *ProjectAnalytics) GetLastViewedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastViewedTime
+	}
+	return nil
+}
+
+// Guidebook is a published, read-only version of a notebook.
+type Guidebook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuidebookId string                 `protobuf:"bytes,1,opt,name=guidebook_id,json=guidebookId,proto3" json:"guidebook_id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ProjectId   string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // the notebook it was published from
+	Status      GuidebookStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=notebooklm.v1alpha1.GuidebookStatus" json:"status,omitempty"`
+	ShareUrl    string                 `protobuf:"bytes,5,opt,name=share_url,json=shareUrl,proto3" json:"share_url,omitempty"`
+	PublishTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+}
+
+func (x *Guidebook) Reset() {
+	*x = Guidebook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Guidebook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guidebook) ProtoMessage() {}
+
+func (x *Guidebook) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guidebook.ProtoReflect.Descriptor instead.
+func (*Guidebook) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Guidebook) GetGuidebookId() string {
+	if x != nil {
+		return x.GuidebookId
+	}
+	return ""
+}
+
+func (x *Guidebook) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Guidebook) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Guidebook) GetStatus() GuidebookStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GuidebookStatus_GUIDEBOOK_STATUS_UNSPECIFIED
+}
+
+func (x *Guidebook) GetShareUrl() string {
+	if x != nil {
+		return x.ShareUrl
+	}
+	return ""
+}
+
+func (x *Guidebook) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
+}
+
+// GuidebookDetails describes how a guidebook is shared.
+type GuidebookDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Guidebook     *Guidebook             `protobuf:"bytes,1,opt,name=guidebook,proto3" json:"guidebook,omitempty"`
+	Access        ProjectAccess          `protobuf:"varint,2,opt,name=access,proto3,enum=notebooklm.v1alpha1.ProjectAccess" json:"access,omitempty"`
+	Collaborators []*Collaborator        `protobuf:"bytes,3,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	ViewCount     *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+}
+
+func (x *GuidebookDetails) Reset() {
+	*x = GuidebookDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuidebookDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuidebookDetails) ProtoMessage() {}
+
+func (x *GuidebookDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuidebookDetails.ProtoReflect.Descriptor instead.
+func (*GuidebookDetails) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GuidebookDetails) GetGuidebook() *Guidebook {
+	if x != nil {
+		return x.Guidebook
+	}
+	return nil
+}
+
+func (x *GuidebookDetails) GetAccess() ProjectAccess {
+	if x != nil {
+		return x.Access
+	}
+	return ProjectAccess_PROJECT_ACCESS_UNSPECIFIED
+}
+
+func (x *GuidebookDetails) GetCollaborators() []*Collaborator {
+	if x != nil {
+		return x.Collaborators
+	}
+	return nil
+}
+
+func (x *GuidebookDetails) GetViewCount() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ViewCount
+	}
+	return nil
+}
+
+type ListRecentlyViewedGuidebooksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Guidebooks []*Guidebook `protobuf:"bytes,1,rep,name=guidebooks,proto3" json:"guidebooks,omitempty"`
+}
+
+func (x *ListRecentlyViewedGuidebooksResponse) Reset() {
+	*x = ListRecentlyViewedGuidebooksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRecentlyViewedGuidebooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecentlyViewedGuidebooksResponse) ProtoMessage() {}
+
+func (x *ListRecentlyViewedGuidebooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecentlyViewedGuidebooksResponse.ProtoReflect.Descriptor instead.
+func (*ListRecentlyViewedGuidebooksResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListRecentlyViewedGuidebooksResponse) GetGuidebooks() []*Guidebook {
+	if x != nil {
+		return x.Guidebooks
+	}
+	return nil
+}
+
+type ShareGuidebookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShareUrl string `protobuf:"bytes,1,opt,name=share_url,json=shareUrl,proto3" json:"share_url,omitempty"`
+}
+
+func (x *ShareGuidebookResponse) Reset() {
+	*x = ShareGuidebookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareGuidebookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareGuidebookResponse) ProtoMessage() {}
+
+func (x *ShareGuidebookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareGuidebookResponse.ProtoReflect.Descriptor instead.
+func (*ShareGuidebookResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ShareGuidebookResponse) GetShareUrl() string {
+	if x != nil {
+		return x.ShareUrl
+	}
+	return ""
+}
+
+type GuidebookGenerateAnswerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Answer string `protobuf:"bytes,1,opt,name=answer,proto3" json:"answer,omitempty"`
+}
+
+func (x *GuidebookGenerateAnswerResponse) Reset() {
+	*x = GuidebookGenerateAnswerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuidebookGenerateAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuidebookGenerateAnswerResponse) ProtoMessage() {}
+
+func (x *GuidebookGenerateAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuidebookGenerateAnswerResponse.ProtoReflect.Descriptor instead.
+func (*GuidebookGenerateAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GuidebookGenerateAnswerResponse) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+// LoadSourceResponse is a source together with the text NotebookLM
+// extracted from it.
+type LoadSourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source  *Source        `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Content *SourceContent `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *LoadSourceResponse) Reset() {
+	*x = LoadSourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadSourceResponse) ProtoMessage() {}
+
+func (x *LoadSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadSourceResponse.ProtoReflect.Descriptor instead.
+func (*LoadSourceResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *LoadSourceResponse) GetSource() *Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *LoadSourceResponse) GetContent() *SourceContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type SourceContent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunks []string `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"` // extracted text, in document order
+}
+
+func (x *SourceContent) Reset() {
+	*x = SourceContent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceContent) ProtoMessage() {}
+
+func (x *SourceContent) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceContent.ProtoReflect.Descriptor instead.
+func (*SourceContent) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SourceContent) GetChunks() []string {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+type CheckSourceFreshnessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NeedsRefresh   bool                   `protobuf:"varint,1,opt,name=needs_refresh,json=needsRefresh,proto3" json:"needs_refresh,omitempty"`
+	LastUpdateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`
+}
+
+func (x *CheckSourceFreshnessResponse) Reset() {
+	*x = CheckSourceFreshnessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckSourceFreshnessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSourceFreshnessResponse) ProtoMessage() {}
+
+func (x *CheckSourceFreshnessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSourceFreshnessResponse.ProtoReflect.Descriptor instead.
+func (*CheckSourceFreshnessResponse) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CheckSourceFreshnessResponse) GetNeedsRefresh() bool {
+	if x != nil {
+		return x.NeedsRefresh
+	}
+	return false
+}
+
+func (x *CheckSourceFreshnessResponse) GetLastUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdateTime
+	}
+	return nil
+}
+
+type ListRecentlyViewedProjectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options int32 `protobuf:"varint,2,opt,name=options,proto3" json:"options,omitempty"` // always 1 in web app traffic
+}
+
+func (x *ListRecentlyViewedProjectsRequest) Reset() {
+	*x = ListRecentlyViewedProjectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRecentlyViewedProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecentlyViewedProjectsRequest) ProtoMessage() {}
+
+func (x *ListRecentlyViewedProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecentlyViewedProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecentlyViewedProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListRecentlyViewedProjectsRequest) GetOptions() int32 {
+	if x != nil {
+		return x.Options
+	}
+	return 0
+}
+
+type CreateProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Emoji string `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+}
+
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateProjectRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type LoadNotebookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *LoadNotebookRequest) Reset() {
+	*x = LoadNotebookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadNotebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadNotebookRequest) ProtoMessage() {}
+
+func (x *LoadNotebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadNotebookRequest.ProtoReflect.Descriptor instead.
+func (*LoadNotebookRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *LoadNotebookRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type DeleteProjectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectIds []string `protobuf:"bytes,1,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
+}
+
+func (x *DeleteProjectsRequest) Reset() {
+	*x = DeleteProjectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectsRequest) ProtoMessage() {}
+
+func (x *DeleteProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteProjectsRequest) GetProjectIds() []string {
+	if x != nil {
+		return x.ProjectIds
+	}
+	return nil
+}
+
+type MutateProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Updates   *Project `protobuf:"bytes,2,opt,name=updates,proto3" json:"updates,omitempty"`
+}
+
+func (x *MutateProjectRequest) Reset() {
+	*x = MutateProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MutateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutateProjectRequest) ProtoMessage() {}
+
+func (x *MutateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutateProjectRequest.ProtoReflect.Descriptor instead.
+func (*MutateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MutateProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *MutateProjectRequest) GetUpdates() *Project {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+type RemoveRecentlyViewedProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *RemoveRecentlyViewedProjectRequest) Reset() {
+	*x = RemoveRecentlyViewedProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveRecentlyViewedProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRecentlyViewedProjectRequest) ProtoMessage() {}
+
+func (x *RemoveRecentlyViewedProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRecentlyViewedProjectRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRecentlyViewedProjectRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RemoveRecentlyViewedProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type AddSourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sources   []*SourceInput `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	ProjectId string         `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *AddSourceRequest) Reset() {
+	*x = AddSourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSourceRequest) ProtoMessage() {}
+
+func (x *AddSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSourceRequest.ProtoReflect.Descriptor instead.
+func (*AddSourceRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AddSourceRequest) GetSources() []*SourceInput {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *AddSourceRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type SourceInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text      *TextSourceInput `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Web       *WebSourceInput  `protobuf:"bytes,3,opt,name=web,proto3" json:"web,omitempty"`
+	InputType int32            `protobuf:"varint,4,opt,name=input_type,json=inputType,proto3" json:"input_type,omitempty"` // 2 for pasted text
+}
+
+func (x *SourceInput) Reset() {
+	*x = SourceInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceInput) ProtoMessage() {}
+
+func (x *SourceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceInput.ProtoReflect.Descriptor instead.
+func (*SourceInput) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SourceInput) GetText() *TextSourceInput {
+	if x != nil {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *SourceInput) GetWeb() *WebSourceInput {
+	if x != nil {
+		return x.Web
+	}
+	return nil
+}
+
+func (x *SourceInput) GetInputType() int32 {
+	if x != nil {
+		return x.InputType
+	}
+	return 0
+}
+
+type TextSourceInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title   string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *TextSourceInput) Reset() {
+	*x = TextSourceInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextSourceInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextSourceInput) ProtoMessage() {}
+
+func (x *TextSourceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextSourceInput.ProtoReflect.Descriptor instead.
+func (*TextSourceInput) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *TextSourceInput) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TextSourceInput) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type WebSourceInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *WebSourceInput) Reset() {
+	*x = WebSourceInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebSourceInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebSourceInput) ProtoMessage() {}
+
+func (x *WebSourceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebSourceInput.ProtoReflect.Descriptor instead.
+func (*WebSourceInput) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *WebSourceInput) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// AddFileSourceRequest is an AddSources request for uploaded files, whose
+// inputs are laid out differently from SourceInput.
+type AddFileSourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sources   []*FileSourceInput `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	ProjectId string             `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *AddFileSourceRequest) Reset() {
+	*x = AddFileSourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFileSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFileSourceRequest) ProtoMessage() {}
+
+func (x *AddFileSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFileSourceRequest.ProtoReflect.Descriptor instead.
+func (*AddFileSourceRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AddFileSourceRequest) GetSources() []*FileSourceInput {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *AddFileSourceRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type FileSourceInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content     string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"` // base64 encoded
+	Filename    string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Encoding    string `protobuf:"bytes,4,opt,name=encoding,proto3" json:"encoding,omitempty"` // "base64"
+}
+
+func (x *FileSourceInput) Reset() {
+	*x = FileSourceInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileSourceInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileSourceInput) ProtoMessage() {}
+
+func (x *FileSourceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileSourceInput.ProtoReflect.Descriptor instead.
+func (*FileSourceInput) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *FileSourceInput) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *FileSourceInput) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *FileSourceInput) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *FileSourceInput) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+// SourceIdList is sent as [[id, ...]]. Requests that select sources with a
+// repeated SourceIdList use every enabled source of the notebook when it is
+// empty.
+type SourceIdList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *SourceIdList) Reset() {
+	*x = SourceIdList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceIdList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceIdList) ProtoMessage() {}
+
+func (x *SourceIdList) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceIdList.ProtoReflect.Descriptor instead.
+func (*SourceIdList) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SourceIdList) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type DeleteSourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sources []*SourceIdList `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *DeleteSourcesRequest) Reset() {
+	*x = DeleteSourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSourcesRequest) ProtoMessage() {}
+
+func (x *DeleteSourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSourcesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSourcesRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteSourcesRequest) GetSources() []*SourceIdList {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type MutateSourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceId string  `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Updates  *Source `protobuf:"bytes,2,opt,name=updates,proto3" json:"updates,omitempty"`
+}
+
+func (x *MutateSourceRequest) Reset() {
+	*x = MutateSourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MutateSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutateSourceRequest) ProtoMessage() {}
+
+func (x *MutateSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutateSourceRequest.ProtoReflect.Descriptor instead.
+func (*MutateSourceRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *MutateSourceRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *MutateSourceRequest) GetUpdates() *Source {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+type RefreshSourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceId string `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+}
+
+func (x *RefreshSourceRequest) Reset() {
+	*x = RefreshSourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSourceRequest) ProtoMessage() {}
+
+func (x *RefreshSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSourceRequest.ProtoReflect.Descriptor instead.
+func (*RefreshSourceRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RefreshSourceRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+type LoadSourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceId string `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+}
+
+func (x *LoadSourceRequest) Reset() {
+	*x = LoadSourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadSourceRequest) ProtoMessage() {}
+
+func (x *LoadSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadSourceRequest.ProtoReflect.Descriptor instead.
+func (*LoadSourceRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *LoadSourceRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+type CheckSourceFreshnessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceId string `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+}
+
+func (x *CheckSourceFreshnessRequest) Reset() {
+	*x = CheckSourceFreshnessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckSourceFreshnessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSourceFreshnessRequest) ProtoMessage() {}
+
+func (x *CheckSourceFreshnessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSourceFreshnessRequest.ProtoReflect.Descriptor instead.
+func (*CheckSourceFreshnessRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CheckSourceFreshnessRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+type ActOnSourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Action    string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	SourceIds []string `protobuf:"bytes,3,rep,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"`
+}
+
+func (x *ActOnSourcesRequest) Reset() {
+	*x = ActOnSourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActOnSourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActOnSourcesRequest) ProtoMessage() {}
+
+func (x *ActOnSourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActOnSourcesRequest.ProtoReflect.Descriptor instead.
+func (*ActOnSourcesRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ActOnSourcesRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ActOnSourcesRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ActOnSourcesRequest) GetSourceIds() []string {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+type CreateNoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string  `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Content   string  `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	NoteType  []int32 `protobuf:"varint,3,rep,packed,name=note_type,json=noteType,proto3" json:"note_type,omitempty"`
+	Title     string  `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *CreateNoteRequest) Reset() {
+	*x = CreateNoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteRequest) ProtoMessage() {}
+
+func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateNoteRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetNoteType() []int32 {
+	if x != nil {
+		return x.NoteType
+	}
+	return nil
+}
+
+func (x *CreateNoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type MutateNoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string        `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	NoteId    string        `protobuf:"bytes,2,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Updates   []*NoteUpdate `protobuf:"bytes,3,rep,name=updates,proto3" json:"updates,omitempty"`
+}
+
+func (x *MutateNoteRequest) Reset() {
+	*x = MutateNoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MutateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutateNoteRequest) ProtoMessage() {}
+
+func (x *MutateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutateNoteRequest.ProtoReflect.Descriptor instead.
+func (*MutateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *MutateNoteRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *MutateNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *MutateNoteRequest) GetUpdates() []*NoteUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+type NoteUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Note *NoteContent `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *NoteUpdate) Reset() {
+	*x = NoteUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NoteUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteUpdate) ProtoMessage() {}
+
+func (x *NoteUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteUpdate.ProtoReflect.Descriptor instead.
+func (*NoteUpdate) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *NoteUpdate) GetNote() *NoteContent {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+type NoteContent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content string     `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Title   string     `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Unknown *EmptyList `protobuf:"bytes,3,opt,name=unknown,proto3" json:"unknown,omitempty"` // always sent empty; what it holds is unknown
+}
+
+func (x *NoteContent) Reset() {
+	*x = NoteContent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NoteContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteContent) ProtoMessage() {}
+
+func (x *NoteContent) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteContent.ProtoReflect.Descriptor instead.
+func (*NoteContent) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *NoteContent) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *NoteContent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NoteContent) GetUnknown() *EmptyList {
+	if x != nil {
+		return x.Unknown
+	}
+	return nil
+}
+
+// EmptyList encodes as [], for positions that must be sent as an empty list.
+type EmptyList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EmptyList) Reset() {
+	*x = EmptyList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyList) ProtoMessage() {}
+
+func (x *EmptyList) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyList.ProtoReflect.Descriptor instead.
+func (*EmptyList) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{77}
+}
+
+type DeleteNotesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notes []*SourceIdList `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *DeleteNotesRequest) Reset() {
+	*x = DeleteNotesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotesRequest) ProtoMessage() {}
+
+func (x *DeleteNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotesRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DeleteNotesRequest) GetNotes() []*SourceIdList {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type GetNotesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *GetNotesRequest) Reset() {
+	*x = GetNotesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotesRequest) ProtoMessage() {}
+
+func (x *GetNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotesRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetNotesRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type CreateAudioOverviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId    string          `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AudioType    *int32          `protobuf:"varint,2,opt,name=audio_type,json=audioType,proto3,oneof" json:"audio_type,omitempty"` // optional so 0 is sent
+	Instructions []string        `protobuf:"bytes,3,rep,name=instructions,proto3" json:"instructions,omitempty"`
+	Sources      []*SourceIdList `protobuf:"bytes,4,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *CreateAudioOverviewRequest) Reset() {
+	*x = CreateAudioOverviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAudioOverviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAudioOverviewRequest) ProtoMessage() {}
+
+func (x *CreateAudioOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAudioOverviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateAudioOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreateAudioOverviewRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateAudioOverviewRequest) GetAudioType() int32 {
+	if x != nil && x.AudioType != nil {
+		return *x.AudioType
+	}
+	return 0
+}
+
+func (x *CreateAudioOverviewRequest) GetInstructions() []string {
+	if x != nil {
+		return x.Instructions
+	}
+	return nil
+}
+
+func (x *CreateAudioOverviewRequest) GetSources() []*SourceIdList {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type GetAudioOverviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId   string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	RequestType int32  `protobuf:"varint,2,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"` // always 1 in web app traffic
+}
+
+func (x *GetAudioOverviewRequest) Reset() {
+	*x = GetAudioOverviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAudioOverviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAudioOverviewRequest) ProtoMessage() {}
+
+func (x *GetAudioOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAudioOverviewRequest.ProtoReflect.Descriptor instead.
+func (*GetAudioOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetAudioOverviewRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetAudioOverviewRequest) GetRequestType() int32 {
+	if x != nil {
+		return x.RequestType
+	}
+	return 0
+}
+
+type DeleteAudioOverviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *DeleteAudioOverviewRequest) Reset() {
+	*x = DeleteAudioOverviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAudioOverviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAudioOverviewRequest) ProtoMessage() {}
+
+func (x *DeleteAudioOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAudioOverviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAudioOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DeleteAudioOverviewRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GenerateDocumentGuidesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *GenerateDocumentGuidesRequest) Reset() {
+	*x = GenerateDocumentGuidesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateDocumentGuidesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateDocumentGuidesRequest) ProtoMessage() {}
+
+func (x *GenerateDocumentGuidesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateDocumentGuidesRequest.ProtoReflect.Descriptor instead.
+func (*GenerateDocumentGuidesRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GenerateDocumentGuidesRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GenerateNotebookGuideRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string          `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Sources   []*SourceIdList `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *GenerateNotebookGuideRequest) Reset() {
+	*x = GenerateNotebookGuideRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateNotebookGuideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateNotebookGuideRequest) ProtoMessage() {}
+
+func (x *GenerateNotebookGuideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateNotebookGuideRequest.ProtoReflect.Descriptor instead.
+func (*GenerateNotebookGuideRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GenerateNotebookGuideRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GenerateNotebookGuideRequest) GetSources() []*SourceIdList {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type GenerateOutlineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string          `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Sources   []*SourceIdList `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *GenerateOutlineRequest) Reset() {
+	*x = GenerateOutlineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateOutlineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateOutlineRequest) ProtoMessage() {}
+
+func (x *GenerateOutlineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateOutlineRequest.ProtoReflect.Descriptor instead.
+func (*GenerateOutlineRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GenerateOutlineRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GenerateOutlineRequest) GetSources() []*SourceIdList {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type GenerateSectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string          `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Sources   []*SourceIdList `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *GenerateSectionRequest) Reset() {
+	*x = GenerateSectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSectionRequest) ProtoMessage() {}
+
+func (x *GenerateSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSectionRequest.ProtoReflect.Descriptor instead.
+func (*GenerateSectionRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GenerateSectionRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GenerateSectionRequest) GetSources() []*SourceIdList {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type GenerateArtifactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string          `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Type      ArtifactType    `protobuf:"varint,2,opt,name=type,proto3,enum=notebooklm.v1alpha1.ArtifactType" json:"type,omitempty"`
+	Sources   []*SourceIdList `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *GenerateArtifactRequest) Reset() {
+	*x = GenerateArtifactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateArtifactRequest) ProtoMessage() {}
+
+func (x *GenerateArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateArtifactRequest.ProtoReflect.Descriptor instead.
+func (*GenerateArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GenerateArtifactRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GenerateArtifactRequest) GetType() ArtifactType {
+	if x != nil {
+		return x.Type
+	}
+	return ArtifactType_ARTIFACT_TYPE_UNSPECIFIED
+}
+
+func (x *GenerateArtifactRequest) GetSources() []*SourceIdList {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type GenerateMindMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string          `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Sources   []*SourceIdList `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *GenerateMindMapRequest) Reset() {
+	*x = GenerateMindMapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateMindMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateMindMapRequest) ProtoMessage() {}
+
+func (x *GenerateMindMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateMindMapRequest.ProtoReflect.Descriptor instead.
+func (*GenerateMindMapRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GenerateMindMapRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GenerateMindMapRequest) GetSources() []*SourceIdList {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type GenerateFlashcardsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string          `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Sources   []*SourceIdList `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *GenerateFlashcardsRequest) Reset() {
+	*x = GenerateFlashcardsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateFlashcardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateFlashcardsRequest) ProtoMessage() {}
+
+func (x *GenerateFlashcardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateFlashcardsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateFlashcardsRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GenerateFlashcardsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GenerateFlashcardsRequest) GetSources() []*SourceIdList {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type GenerateQuizRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string          `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Sources   []*SourceIdList `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *GenerateQuizRequest) Reset() {
+	*x = GenerateQuizRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateQuizRequest) ProtoMessage() {}
+
+func (x *GenerateQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateQuizRequest.ProtoReflect.Descriptor instead.
+func (*GenerateQuizRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GenerateQuizRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GenerateQuizRequest) GetSources() []*SourceIdList {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type StartDraftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *StartDraftRequest) Reset() {
+	*x = StartDraftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDraftRequest) ProtoMessage() {}
+
+func (x *StartDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDraftRequest.ProtoReflect.Descriptor instead.
+func (*StartDraftRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *StartDraftRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type StartSectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *StartSectionRequest) Reset() {
+	*x = StartSectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSectionRequest) ProtoMessage() {}
+
+func (x *StartSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSectionRequest.ProtoReflect.Descriptor instead.
+func (*StartSectionRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *StartSectionRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetChatHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *GetChatHistoryRequest) Reset() {
+	*x = GetChatHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChatHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatHistoryRequest) ProtoMessage() {}
+
+func (x *GetChatHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetChatHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetChatHistoryRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type DeleteChatHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *DeleteChatHistoryRequest) Reset() {
+	*x = DeleteChatHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteChatHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChatHistoryRequest) ProtoMessage() {}
+
+func (x *DeleteChatHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChatHistoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChatHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DeleteChatHistoryRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// ChatRequest asks a question grounded in a notebook's sources. It is sent
+// to the GenerateFreeFormStreamed endpoint, not through batchexecute, so the
+// notebook is passed as the call's NotebookID.
+type ChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sources        []*SourceIdList `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"` // sources to ground the answer in
+	Question       string          `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	History        []*ChatTurn     `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"`                                     // earlier turns, oldest first
+	ConversationId string          `protobuf:"bytes,4,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // empty to start a conversation
+}
+
+func (x *ChatRequest) Reset() {
+	*x = ChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatRequest) ProtoMessage() {}
+
+func (x *ChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatRequest.ProtoReflect.Descriptor instead.
+func (*ChatRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ChatRequest) GetSources() []*SourceIdList {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *ChatRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *ChatRequest) GetHistory() []*ChatTurn {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *ChatRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type GetOrCreateAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetOrCreateAccountRequest) Reset() {
+	*x = GetOrCreateAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrCreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrCreateAccountRequest) ProtoMessage() {}
+
+func (x *GetOrCreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrCreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetOrCreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{96}
+}
+
+type MutateAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *AccountSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *MutateAccountRequest) Reset() {
+	*x = MutateAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MutateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutateAccountRequest) ProtoMessage() {}
+
+func (x *MutateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutateAccountRequest.ProtoReflect.Descriptor instead.
+func (*MutateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *MutateAccountRequest) GetSettings() *AccountSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type SubmitFeedbackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId    string           `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // optional
+	FeedbackText string           `protobuf:"bytes,2,opt,name=feedback_text,json=feedbackText,proto3" json:"feedback_text,omitempty"`
+	Context      *FeedbackContext `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *SubmitFeedbackRequest) Reset() {
+	*x = SubmitFeedbackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitFeedbackRequest) ProtoMessage() {}
+
+func (x *SubmitFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*SubmitFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SubmitFeedbackRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SubmitFeedbackRequest) GetFeedbackText() string {
+	if x != nil {
+		return x.FeedbackText
+	}
+	return ""
+}
+
+func (x *SubmitFeedbackRequest) GetContext() *FeedbackContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+// FeedbackContext carries optional diagnostics sent along with feedback.
+type FeedbackContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Client    string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`                        // e.g. "nlm/v0.1.0 (linux/amd64)"
+	DebugInfo string `protobuf:"bytes,2,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"` // free-form diagnostic details
+}
+
+func (x *FeedbackContext) Reset() {
+	*x = FeedbackContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedbackContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedbackContext) ProtoMessage() {}
+
+func (x *FeedbackContext) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedbackContext.ProtoReflect.Descriptor instead.
+func (*FeedbackContext) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *FeedbackContext) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *FeedbackContext) GetDebugInfo() string {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return ""
+}
+
+type GetProjectAnalyticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *GetProjectAnalyticsRequest) Reset() {
+	*x = GetProjectAnalyticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProjectAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetProjectAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetProjectAnalyticsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetProjectDetailsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *GetProjectDetailsRequest) Reset() {
+	*x = GetProjectDetailsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProjectDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectDetailsRequest) ProtoMessage() {}
+
+func (x *GetProjectDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetProjectDetailsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ShareProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string        `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Access    ProjectAccess `protobuf:"varint,2,opt,name=access,proto3,enum=notebooklm.v1alpha1.ProjectAccess" json:"access,omitempty"` // unset leaves link access unchanged
+	// Collaborators to add, update or, with PROJECT_ROLE_REMOVED, remove.
+	// Collaborators not listed are left unchanged.
+	Collaborators []*Collaborator `protobuf:"bytes,3,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+}
+
+func (x *ShareProjectRequest) Reset() {
+	*x = ShareProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareProjectRequest) ProtoMessage() {}
+
+func (x *ShareProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareProjectRequest.ProtoReflect.Descriptor instead.
+func (*ShareProjectRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ShareProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ShareProjectRequest) GetAccess() ProjectAccess {
+	if x != nil {
+		return x.Access
+	}
+	return ProjectAccess_PROJECT_ACCESS_UNSPECIFIED
+}
+
+func (x *ShareProjectRequest) GetCollaborators() []*Collaborator {
+	if x != nil {
+		return x.Collaborators
+	}
+	return nil
+}
+
+type ListRecentlyViewedGuidebooksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListRecentlyViewedGuidebooksRequest) Reset() {
+	*x = ListRecentlyViewedGuidebooksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRecentlyViewedGuidebooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecentlyViewedGuidebooksRequest) ProtoMessage() {}
+
+func (x *ListRecentlyViewedGuidebooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecentlyViewedGuidebooksRequest.ProtoReflect.Descriptor instead.
+func (*ListRecentlyViewedGuidebooksRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{103}
+}
+
+type GetGuidebookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuidebookId string `protobuf:"bytes,1,opt,name=guidebook_id,json=guidebookId,proto3" json:"guidebook_id,omitempty"`
+}
+
+func (x *GetGuidebookRequest) Reset() {
+	*x = GetGuidebookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGuidebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuidebookRequest) ProtoMessage() {}
+
+func (x *GetGuidebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuidebookRequest.ProtoReflect.Descriptor instead.
+func (*GetGuidebookRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetGuidebookRequest) GetGuidebookId() string {
+	if x != nil {
+		return x.GuidebookId
+	}
+	return ""
+}
+
+type GetGuidebookDetailsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuidebookId string `protobuf:"bytes,1,opt,name=guidebook_id,json=guidebookId,proto3" json:"guidebook_id,omitempty"`
+}
+
+func (x *GetGuidebookDetailsRequest) Reset() {
+	*x = GetGuidebookDetailsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGuidebookDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuidebookDetailsRequest) ProtoMessage() {}
+
+func (x *GetGuidebookDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuidebookDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetGuidebookDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetGuidebookDetailsRequest) GetGuidebookId() string {
+	if x != nil {
+		return x.GuidebookId
+	}
+	return ""
+}
+
+type PublishGuidebookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Title     string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"` // defaults to the notebook title
+}
+
+func (x *PublishGuidebookRequest) Reset() {
+	*x = PublishGuidebookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishGuidebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishGuidebookRequest) ProtoMessage() {}
+
+func (x *PublishGuidebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishGuidebookRequest.ProtoReflect.Descriptor instead.
+func (*PublishGuidebookRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *PublishGuidebookRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *PublishGuidebookRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type ShareGuidebookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuidebookId   string          `protobuf:"bytes,1,opt,name=guidebook_id,json=guidebookId,proto3" json:"guidebook_id,omitempty"`
+	Access        ProjectAccess   `protobuf:"varint,2,opt,name=access,proto3,enum=notebooklm.v1alpha1.ProjectAccess" json:"access,omitempty"`
+	Collaborators []*Collaborator `protobuf:"bytes,3,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+}
+
+func (x *ShareGuidebookRequest) Reset() {
+	*x = ShareGuidebookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareGuidebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareGuidebookRequest) ProtoMessage() {}
+
+func (x *ShareGuidebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareGuidebookRequest.ProtoReflect.Descriptor instead.
+func (*ShareGuidebookRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ShareGuidebookRequest) GetGuidebookId() string {
+	if x != nil {
+		return x.GuidebookId
+	}
+	return ""
+}
+
+func (x *ShareGuidebookRequest) GetAccess() ProjectAccess {
+	if x != nil {
+		return x.Access
+	}
+	return ProjectAccess_PROJECT_ACCESS_UNSPECIFIED
+}
+
+func (x *ShareGuidebookRequest) GetCollaborators() []*Collaborator {
+	if x != nil {
+		return x.Collaborators
+	}
+	return nil
+}
+
+type DeleteGuidebookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuidebookId string `protobuf:"bytes,1,opt,name=guidebook_id,json=guidebookId,proto3" json:"guidebook_id,omitempty"`
+}
+
+func (x *DeleteGuidebookRequest) Reset() {
+	*x = DeleteGuidebookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGuidebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGuidebookRequest) ProtoMessage() {}
+
+func (x *DeleteGuidebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGuidebookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGuidebookRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *DeleteGuidebookRequest) GetGuidebookId() string {
+	if x != nil {
+		return x.GuidebookId
+	}
+	return ""
+}
+
+type GuidebookGenerateAnswerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuidebookId string `protobuf:"bytes,1,opt,name=guidebook_id,json=guidebookId,proto3" json:"guidebook_id,omitempty"`
+	Question    string `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+}
+
+func (x *GuidebookGenerateAnswerRequest) Reset() {
+	*x = GuidebookGenerateAnswerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuidebookGenerateAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuidebookGenerateAnswerRequest) ProtoMessage() {}
+
+func (x *GuidebookGenerateAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuidebookGenerateAnswerRequest.ProtoReflect.Descriptor instead.
+func (*GuidebookGenerateAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GuidebookGenerateAnswerRequest) GetGuidebookId() string {
+	if x != nil {
+		return x.GuidebookId
+	}
+	return ""
+}
+
+func (x *GuidebookGenerateAnswerRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+type ShareAudioRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShareOptions []int32 `protobuf:"varint,1,rep,packed,name=share_options,json=shareOptions,proto3" json:"share_options,omitempty"`
+	ProjectId    string  `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *ShareAudioRequest) Reset() {
+	*x = ShareAudioRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareAudioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareAudioRequest) ProtoMessage() {}
+
+func (x *ShareAudioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareAudioRequest.ProtoReflect.Descriptor instead.
+func (*ShareAudioRequest) Descriptor() ([]byte, []int) {
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ShareAudioRequest) GetShareOptions() []int32 {
+	if x != nil {
+		return x.ShareOptions
+	}
+	return nil
+}
+
+func (x *ShareAudioRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+var File_notebooklm_v1alpha1_notebooklm_proto protoreflect.FileDescriptor
+
+var file_notebooklm_v1alpha1_notebooklm_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72,
+	0x70, 0x63, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xdd, 0x02, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a,
+	0x69, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x62, 0x6f,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x52, 0x0a, 0x11, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x6f, 0x74,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x10, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x74, 0x62, 0x6f, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3b, 0x0a, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x04, 0x67, 0x6f,
+	0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x4c, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x23, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x59, 0x0a, 0x10, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65,
+	0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x45, 0x0a, 0x0f, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x22, 0xbc, 0x02, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0a,
+	0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x09, 0x69, 0x73, 0x53, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x22,
+	0x27, 0x0a, 0x08, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0x95, 0x02, 0x0a, 0x06, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x64, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x9d, 0x03, 0x0a, 0x0e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x50, 0x0a, 0x0b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x5f, 0x64, 0x6f,
+	0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x63, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x59, 0x6f, 0x75,
+	0x74, 0x75, 0x62, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x07, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x12, 0x54, 0x0a,
+	0x18, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x48, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x6c, 0x61, 0x73,
+	0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a,
+	0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42,
+	0x0f, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x3b, 0x0a, 0x18, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x63, 0x73, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x53, 0x0a,
+	0x15, 0x59, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x79, 0x6f, 0x75,
+	0x74, 0x75, 0x62, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x49, 0x64, 0x22, 0xd9, 0x01, 0x0a, 0x0e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x7d, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1d, 0x0a, 0x19, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19,
+	0x0a, 0x15, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42,
+	0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x22, 0xf0,
+	0x04, 0x0a, 0x0b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x3f,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27,
+	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x9f, 0x04, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x4d,
+	0x50, 0x4f, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x50, 0x45, 0x52, 0x4d, 0x41, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x49, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x4d, 0x49, 0x4d, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x59, 0x4f, 0x55, 0x54, 0x55, 0x42, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x47, 0x45,
+	0x4e, 0x45, 0x52, 0x49, 0x43, 0x10, 0x06, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x59, 0x4f, 0x55, 0x54, 0x55, 0x42, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x55, 0x4e, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x44, 0x10, 0x07, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x59, 0x4f, 0x55, 0x54, 0x55, 0x42, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x08, 0x12, 0x25, 0x0a, 0x21,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x59, 0x4f, 0x55, 0x54, 0x55, 0x42, 0x45, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x53, 0x5f, 0x4f, 0x4e, 0x4c,
+	0x59, 0x10, 0x09, 0x12, 0x27, 0x0a, 0x23, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x59, 0x4f,
+	0x55, 0x54, 0x55, 0x42, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x49,
+	0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x24, 0x0a, 0x20,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45, 0x5f, 0x44, 0x4f,
+	0x43, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x49, 0x43,
+	0x10, 0x0b, 0x12, 0x26, 0x0a, 0x22, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x47, 0x4f, 0x4f,
+	0x47, 0x4c, 0x45, 0x5f, 0x44, 0x4f, 0x43, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4e,
+	0x4f, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x0c, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45, 0x5f, 0x44, 0x4f, 0x43, 0x53,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x0d,
+	0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x4c,
+	0x4f, 0x41, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x0e, 0x12, 0x12, 0x0a,
+	0x0e, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x0f, 0x22, 0x45, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x15, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x49, 0x0a, 0x0e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x76,
+	0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x0d, 0x61,
+	0x75, 0x64, 0x69, 0x6f, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x22, 0x8d, 0x01, 0x0a,
+	0x0d, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x22, 0x5c, 0x0a, 0x1e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x47, 0x75, 0x69, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a,
+	0x0a, 0x06, 0x67, 0x75, 0x69, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x75, 0x69,
+	0x64, 0x65, 0x52, 0x06, 0x67, 0x75, 0x69, 0x64, 0x65, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0d, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x75, 0x69, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x22, 0xac, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x75, 0x69, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x57, 0x0a, 0x13, 0x73, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x73, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x47, 0x0a, 0x11, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x22, 0x33, 0x0a, 0x17, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x33,
+	0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
+	0x4f, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x64, 0x4d,
+	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
+	0x69, 0x6e, 0x64, 0x4d, 0x61, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+	0x22, 0x9f, 0x01, 0x0a, 0x0b, 0x4d, 0x69, 0x6e, 0x64, 0x4d, 0x61, 0x70, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x3c, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
+	0x69, 0x6e, 0x64, 0x4d, 0x61, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x72, 0x65, 0x6e, 0x12, 0x3c, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x73, 0x22, 0x5c, 0x0a, 0x1a, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x6c,
+	0x61, 0x73, 0x68, 0x63, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x0a, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x73, 0x68,
+	0x63, 0x61, 0x72, 0x64, 0x52, 0x0a, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x63, 0x61, 0x72, 0x64, 0x73,
+	0x22, 0x73, 0x0a, 0x09, 0x46, 0x6c, 0x61, 0x73, 0x68, 0x63, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x72,
+	0x6f, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x3c, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x64, 0x73, 0x22, 0x57, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc7,
+	0x01, 0x0a, 0x0c, 0x51, 0x75, 0x69, 0x7a, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c,
+	0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65,
+	0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0a, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x52, 0x09, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16,
+	0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22,
+	0x9a, 0x01, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x63,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x22, 0x5c, 0x0a, 0x08,
+	0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x22, 0x51, 0x0a, 0x08, 0x43, 0x68,
+	0x61, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x56, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x96, 0x02, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x63,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x5e,
+	0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x56, 0x69,
+	0x65, 0x77, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x53,
+	0x0a, 0x12, 0x53, 0x68, 0x61, 0x72, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x43, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49, 0x64, 0x22, 0x88, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x3a, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x47, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x22, 0x7d, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x34, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x6f, 0x0a, 0x14, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x3a, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x06, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x22, 0x4b, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x22, 0xf6, 0x01, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x3a, 0x0a, 0x06, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52,
+	0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xff, 0x01, 0x0a, 0x0d, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x6d,
+	0x61, 0x78, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0c, 0x6d, 0x61, 0x78, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x54, 0x0a,
+	0x18, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x6d, 0x61,
+	0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x50, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x12, 0x56, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x61, 0x69, 0x6c, 0x79,
+	0x5f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x0f,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x45, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x4b, 0x0a, 0x13, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x12, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0xdb, 0x02, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x76, 0x69, 0x65, 0x77,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x76, 0x69, 0x65, 0x77, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x13, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a,
+	0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0xfd, 0x01, 0x0a, 0x09, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x12,
+	0x21, 0x0a, 0x0c, 0x67, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x75,
+	0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x55,
+	0x72, 0x6c, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x91, 0x02, 0x0a, 0x10, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x67, 0x75, 0x69, 0x64, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x09, 0x67, 0x75, 0x69, 0x64, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x3a, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x47, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c,
+	0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x76, 0x69, 0x65,
+	0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x76, 0x69, 0x65, 0x77,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x66, 0x0a, 0x24, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x6e, 0x74, 0x6c, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x47, 0x75, 0x69, 0x64, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
+	0x0a, 0x67, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x52, 0x0a, 0x67, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x35, 0x0a,
+	0x16, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x55, 0x72, 0x6c, 0x22, 0x39, 0x0a, 0x1f, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22,
+	0x87, 0x01, 0x0a, 0x12, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e,
+	0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x0d, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x1c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x46, 0x72, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x65, 0x65, 0x64,
+	0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x44, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3d,
+	0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x56, 0x69,
+	0x65, 0x77, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x42, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a,
+	0x69, 0x22, 0x3a, 0x0a, 0x13, 0x4c, 0x6f, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3,
+	0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x38, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x22, 0x73, 0x0a, 0x14, 0x4d, 0x75, 0x74, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x22,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x56, 0x69,
+	0x65, 0x77, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x22, 0x73, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x35, 0x0a, 0x03, 0x77, 0x65, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x52, 0x03, 0x77, 0x65, 0x62, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x41, 0x0a, 0x0f, 0x54, 0x65, 0x78, 0x74, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x0e, 0x57, 0x65, 0x62,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x7b, 0x0a,
+	0x14, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x07, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x0f, 0x46,
+	0x69, 0x6c, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x22, 0x20, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x53, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a,
+	0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x69, 0x0a, 0x13, 0x4d, 0x75,
+	0x74, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x35,
+	0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x11, 0x4c, 0x6f,
+	0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x1b,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x72, 0x65, 0x73, 0x68,
+	0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x13, 0x41, 0x63, 0x74, 0x4f,
+	0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x11, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8,
+	0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e,
+	0x6f, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x22, 0x42, 0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x34, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x77, 0x0a, 0x0b, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x22,
+	0x0b, 0x0a, 0x09, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x36, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75,
+	0x64, 0x69, 0x6f, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x6f,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x61,
+	0x75, 0x64, 0x69, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0c, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x3b, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x61, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x41,
+	0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x4f, 0x76, 0x65,
+	0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x22, 0x44, 0x0a, 0x1d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x75, 0x69, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x75, 0x69, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3,
+	0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a,
+	0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x16, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x64, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46,
+	0x6c, 0x61, 0x73, 0x68, 0x63, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x22, 0x77, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75,
+	0x69, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8,
+	0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x3b,
+	0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x11, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x22, 0x3c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22,
+	0x3f, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x22, 0xc8, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3b, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x07, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x6f, 0x74,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x43, 0x68, 0x61, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x4f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x14, 0x4d, 0x75, 0x74, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x40, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x65, 0x65,
+	0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x54, 0x65, 0x78, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x48, 0x0a, 0x0f, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x41, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x22, 0xbf, 0x01, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x3a, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x47, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
+	0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x25, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x47, 0x75, 0x69, 0x64,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x38, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x75, 0x69, 0x64,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x47, 0x75,
+	0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x75, 0x69,
+	0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x17, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3, 0x18, 0x01, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0xbf,
+	0x01, 0x0a, 0x15, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x75, 0x69, 0x64,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x67, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
+	0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x22, 0x3b, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x75,
+	0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x67, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x22, 0x5f, 0x0a,
+	0x1e, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x67, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d,
+	0x0a, 0x11, 0x53, 0x68, 0x61, 0x72, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xf3,
+	0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x2a, 0xa2, 0x01,
+	0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x47, 0x6f, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e,
+	0x56, 0x45, 0x52, 0x53, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x47, 0x4f, 0x41, 0x4c,
+	0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x43, 0x4f,
+	0x4e, 0x56, 0x45, 0x52, 0x53, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x47, 0x4f, 0x41,
+	0x4c, 0x5f, 0x4c, 0x45, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x47, 0x55, 0x49, 0x44, 0x45,
+	0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d,
+	0x10, 0x03, 0x2a, 0x87, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53,
+	0x45, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
+	0x53, 0x45, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f,
+	0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x45, 0x52, 0x10, 0x02, 0x12,
+	0x1b, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x4c, 0x45, 0x4e, 0x47,
+	0x54, 0x48, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x45, 0x52, 0x10, 0x03, 0x2a, 0x8f, 0x01, 0x0a,
+	0x0b, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x18,
+	0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52,
+	0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x4f,
+	0x4c, 0x45, 0x5f, 0x45, 0x44, 0x49, 0x54, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x50,
+	0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x56, 0x49, 0x45, 0x57,
+	0x45, 0x52, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x8f,
+	0x02, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a,
+	0x17, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45, 0x5f, 0x44, 0x4f, 0x43, 0x53, 0x10, 0x03,
+	0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45, 0x5f, 0x53, 0x4c, 0x49, 0x44, 0x45, 0x53, 0x10, 0x04, 0x12,
+	0x1d, 0x0a, 0x19, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47,
+	0x4f, 0x4f, 0x47, 0x4c, 0x45, 0x5f, 0x53, 0x48, 0x45, 0x45, 0x54, 0x53, 0x10, 0x05, 0x12, 0x1a,
+	0x0a, 0x16, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f,
+	0x43, 0x41, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x06, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x45, 0x42, 0x5f, 0x50, 0x41,
+	0x47, 0x45, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x45, 0x10,
+	0x08, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x59, 0x4f, 0x55, 0x54, 0x55, 0x42, 0x45, 0x5f, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x10, 0x09,
+	0x2a, 0x4e, 0x0a, 0x08, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x15,
+	0x43, 0x48, 0x41, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x41, 0x54, 0x5f,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x43,
+	0x48, 0x41, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x02,
+	0x2a, 0x73, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x23, 0x0a, 0x1f, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x45,
+	0x53, 0x53, 0x5f, 0x41, 0x4e, 0x59, 0x4f, 0x4e, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4c,
+	0x49, 0x4e, 0x4b, 0x10, 0x02, 0x2a, 0x5d, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f,
+	0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x50, 0x4c,
+	0x41, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a,
+	0x11, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x50, 0x4c,
+	0x55, 0x53, 0x10, 0x02, 0x2a, 0x6f, 0x0a, 0x0f, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x1c, 0x47, 0x55, 0x49, 0x44, 0x45,
+	0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x55, 0x49,
+	0x44, 0x45, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x52,
+	0x41, 0x46, 0x54, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x55, 0x49, 0x44, 0x45, 0x42, 0x4f,
+	0x4f, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53,
+	0x48, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x9f, 0x01, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41,
+	0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41, 0x43,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x41, 0x51, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19,
+	0x41, 0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54,
+	0x55, 0x44, 0x59, 0x5f, 0x47, 0x55, 0x49, 0x44, 0x45, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x41,
+	0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x52, 0x49,
+	0x45, 0x46, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x4f, 0x43, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x41,
+	0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x49, 0x4d,
+	0x45, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x04, 0x32, 0x9e, 0x21, 0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x4c, 0x4d, 0x12, 0x9c, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x36, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e,
+	0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79,
+	0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x77, 0x58, 0x62, 0x68,
+	0x73, 0x66, 0x90, 0x02, 0x01, 0x12, 0x64, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x29, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22,
+	0x0a, 0xc2, 0xf3, 0x18, 0x06, 0x43, 0x43, 0x71, 0x46, 0x76, 0x66, 0x12, 0x63, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x28, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4c, 0x6f, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x72, 0x4c, 0x4d, 0x31, 0x4e, 0x65, 0x90, 0x02, 0x01,
+	0x12, 0x63, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x12, 0x2a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x57, 0x57, 0x49, 0x4e,
+	0x71, 0x62, 0x90, 0x02, 0x02, 0x12, 0x67, 0x0a, 0x0d, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x29, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x75, 0x74,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22,
+	0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x73, 0x30, 0x74, 0x63, 0x32, 0x64, 0x90, 0x02, 0x02, 0x12, 0x7d,
+	0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79,
+	0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x37, 0x2e,
+	0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x6c, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x0d,
+	0xc2, 0xf3, 0x18, 0x06, 0x66, 0x65, 0x6a, 0x6c, 0x37, 0x65, 0x90, 0x02, 0x02, 0x12, 0x68, 0x0a,
+	0x0a, 0x41, 0x64, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x6e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0a, 0xc2, 0xf3, 0x18,
+	0x06, 0x69, 0x7a, 0x41, 0x6f, 0x44, 0x64, 0x12, 0x70, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0a, 0xc2,
+	0xf3, 0x18, 0x06, 0x69, 0x7a, 0x41, 0x6f, 0x44, 0x64, 0x12, 0x64, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x6e, 0x6f, 0x74,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x10, 0xc2,
+	0xf3, 0x18, 0x05, 0x74, 0x47, 0x4d, 0x42, 0x4a, 0xd0, 0xf3, 0x18, 0x01, 0x90, 0x02, 0x02, 0x12,
+	0x64, 0x0a, 0x0c, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x28, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x62, 0x37, 0x57, 0x66,
+	0x6a, 0x65, 0x90, 0x02, 0x02, 0x12, 0x66, 0x0a, 0x0d, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x0d,
+	0xc2, 0xf3, 0x18, 0x06, 0x46, 0x4c, 0x6d, 0x4a, 0x71, 0x65, 0x90, 0x02, 0x02, 0x12, 0x6c, 0x0a,
+	0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x6e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0d, 0xc2, 0xf3,
+	0x18, 0x06, 0x68, 0x69, 0x7a, 0x6f, 0x4a, 0x63, 0x90, 0x02, 0x01, 0x12, 0x8a, 0x01, 0x0a, 0x14,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x72, 0x65, 0x73, 0x68,
+	0x6e, 0x65, 0x73, 0x73, 0x12, 0x30, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x72, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x72, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x79,
+	0x52, 0x39, 0x59, 0x6f, 0x66, 0x90, 0x02, 0x01, 0x12, 0x5f, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x4f,
+	0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41,
+	0x63, 0x74, 0x4f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06,
+	0x79, 0x79, 0x72, 0x79, 0x4a, 0x65, 0x90, 0x02, 0x02, 0x12, 0x5d, 0x0a, 0x0a, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x0a, 0xc2, 0xf3,
+	0x18, 0x06, 0x43, 0x59, 0x4b, 0x30, 0x58, 0x62, 0x12, 0x60, 0x0a, 0x0a, 0x4d, 0x75, 0x74, 0x61,
+	0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x75, 0x74,
+	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x0d, 0xc2, 0xf3, 0x18,
+	0x06, 0x63, 0x59, 0x41, 0x66, 0x54, 0x62, 0x90, 0x02, 0x02, 0x12, 0x61, 0x0a, 0x0b, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x11, 0xc2, 0xf3, 0x18, 0x06,
+	0x41, 0x48, 0x30, 0x6d, 0x77, 0x64, 0xd0, 0xf3, 0x18, 0x01, 0x90, 0x02, 0x02, 0x12, 0x65, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0xc2, 0xf3, 0x18, 0x05, 0x63, 0x46, 0x6a, 0x69,
+	0x39, 0x90, 0x02, 0x01, 0x12, 0x7e, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75,
+	0x64, 0x69, 0x6f, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x2f, 0x2e, 0x6e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x4f, 0x76, 0x65,
+	0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6e,
+	0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0a, 0xc2, 0xf3, 0x18, 0x06, 0x41, 0x48,
+	0x79, 0x48, 0x72, 0x64, 0x12, 0x7b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f,
+	0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x2c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x56, 0x55, 0x73, 0x69, 0x79, 0x62, 0x90, 0x02,
+	0x01, 0x12, 0x6d, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f,
+	0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x2f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x73, 0x4a, 0x44, 0x62, 0x69, 0x63, 0x90, 0x02, 0x02,
+	0x12, 0x8d, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x75, 0x69, 0x64, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x6e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x47, 0x75, 0x69, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x33, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x75, 0x69, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0a, 0xc2, 0xf3, 0x18, 0x06, 0x74, 0x72, 0x30, 0x33, 0x32, 0x65,
+	0x12, 0x8a, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x75, 0x69, 0x64, 0x65, 0x12, 0x31, 0x2e, 0x6e, 0x6f, 0x74,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x47, 0x75, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
+	0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x75, 0x69, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x0a, 0xc2, 0xf3, 0x18, 0x06, 0x56, 0x66, 0x41, 0x5a, 0x6a, 0x64, 0x12, 0x77, 0x0a,
+	0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x2b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f,
+	0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x6c,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x09, 0xc2, 0xf3, 0x18,
+	0x05, 0x6c, 0x43, 0x6a, 0x41, 0x64, 0x12, 0x78, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0a, 0xc2, 0xf3, 0x18, 0x06, 0x42, 0x65, 0x54, 0x72, 0x59, 0x64,
+	0x12, 0x7b, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x12, 0x2c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x0a, 0xc2, 0xf3, 0x18, 0x06, 0x63, 0x69, 0x79, 0x55, 0x76, 0x66, 0x12, 0x78, 0x0a,
+	0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x64, 0x4d, 0x61, 0x70,
+	0x12, 0x2b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d,
+	0x69, 0x6e, 0x64, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x64,
+	0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0a, 0xc2, 0xf3, 0x18,
+	0x06, 0x4d, 0x77, 0x33, 0x63, 0x79, 0x66, 0x12, 0x81, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x73, 0x68, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2e,
+	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61,
+	0x73, 0x68, 0x63, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61,
+	0x73, 0x68, 0x63, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x0a, 0xc2, 0xf3, 0x18, 0x06, 0x74, 0x38, 0x6b, 0x6d, 0x5a, 0x65, 0x12, 0x6f, 0x0a, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x12, 0x28, 0x2e, 0x6e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x0a, 0xc2, 0xf3, 0x18, 0x06, 0x53, 0x78, 0x30, 0x4c, 0x72, 0x64, 0x12, 0x69, 0x0a, 0x0a,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x26, 0x2e, 0x6e, 0x6f, 0x74,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0a, 0xc2, 0xf3, 0x18,
+	0x06, 0x65, 0x78, 0x58, 0x76, 0x47, 0x66, 0x12, 0x6f, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0a, 0xc2, 0xf3,
+	0x18, 0x06, 0x70, 0x47, 0x43, 0x37, 0x67, 0x66, 0x12, 0x77, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2a, 0x2e, 0x6e, 0x6f, 0x74,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x0c, 0xc2, 0xf3, 0x18, 0x05, 0x6b, 0x68, 0x71, 0x5a, 0x7a, 0x90, 0x02,
+	0x01, 0x12, 0x69, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x0d, 0xc2,
+	0xf3, 0x18, 0x06, 0x4a, 0x37, 0x47, 0x74, 0x68, 0x63, 0x90, 0x02, 0x02, 0x12, 0x71, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x4f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x5a, 0x77, 0x56, 0x63, 0x4f, 0x63, 0x90, 0x02, 0x02, 0x12,
+	0x67, 0x0a, 0x0d, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x29, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x68,
+	0x54, 0x35, 0x34, 0x76, 0x63, 0x90, 0x02, 0x02, 0x12, 0x7c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12,
+	0x2f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x41, 0x55, 0x72,
+	0x7a, 0x4d, 0x62, 0x90, 0x02, 0x01, 0x12, 0x60, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x2a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x0a, 0xc2, 0xf3,
+	0x18, 0x06, 0x75, 0x4e, 0x79, 0x4a, 0x4b, 0x65, 0x32, 0xed, 0x02, 0x0a, 0x11, 0x4e, 0x6f, 0x74,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x4c, 0x4d, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x6c,
+	0x0a, 0x0a, 0x53, 0x68, 0x61, 0x72, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x26, 0x2e, 0x6e,
+	0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0d, 0xc2,
+	0xf3, 0x18, 0x06, 0x52, 0x47, 0x50, 0x39, 0x37, 0x62, 0x90, 0x02, 0x02, 0x12, 0x76, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x2d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x4a, 0x46, 0x4d, 0x44, 0x47,
+	0x64, 0x90, 0x02, 0x01, 0x12, 0x72, 0x0a, 0x0c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x28, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x51,
+	0x44, 0x79, 0x75, 0x72, 0x65, 0x90, 0x02, 0x02, 0x32, 0x82, 0x07, 0x0a, 0x14, 0x4e, 0x6f, 0x74,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x4c, 0x4d, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x73, 0x12, 0x65, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x75, 0x69, 0x64, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x2b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x41,
+	0x52, 0x47, 0x6b, 0x56, 0x63, 0x90, 0x02, 0x02, 0x12, 0x66, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x47,
+	0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x28, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x22, 0x0c, 0xc2, 0xf3, 0x18, 0x05, 0x45, 0x59, 0x71, 0x74, 0x55, 0x90, 0x02, 0x01,
+	0x12, 0xa2, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c,
+	0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x73, 0x12, 0x38, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65,
+	0x6e, 0x74, 0x6c, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x56, 0x69,
+	0x65, 0x77, 0x65, 0x64, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x59, 0x4a, 0x42, 0x70,
+	0x48, 0x63, 0x90, 0x02, 0x01, 0x12, 0x6c, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x2c, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x75,
+	0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x22, 0x0a, 0xc2, 0xf3, 0x18, 0x06, 0x52, 0x36, 0x73,
+	0x6d, 0x61, 0x65, 0x12, 0x7c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2f, 0x2e, 0x6e, 0x6f, 0x74,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x22, 0x0d, 0xc2, 0xf3, 0x18, 0x06, 0x4c, 0x4a, 0x79, 0x7a, 0x65, 0x62, 0x90, 0x02,
+	0x01, 0x12, 0x77, 0x0a, 0x0e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x12, 0x2a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47,
+	0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x75, 0x69, 0x64, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0xc2, 0xf3,
+	0x18, 0x05, 0x4f, 0x54, 0x6c, 0x30, 0x4b, 0x90, 0x02, 0x02, 0x12, 0x90, 0x01, 0x0a, 0x17, 0x47,
+	0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x33, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x75, 0x69,
+	0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x75, 0x69, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x0a, 0xc2, 0xf3, 0x18, 0x06, 0x69, 0x74, 0x41, 0x30, 0x70, 0x63, 0x42, 0xec, 0x01,
+	0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x0f, 0x4e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x53, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x62, 0x69, 0x67, 0x6e, 0x69, 0x65,
+	0x77, 0x2d, 0x6d, 0x61, 0x6c, 0x69, 0x6e, 0x6f, 0x77, 0x73, 0x6b, 0x69, 0x2f, 0x6e, 0x6c, 0x6d,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x6e,
+	0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0xa2, 0x02, 0x03, 0x4e, 0x58, 0x58, 0xaa, 0x02, 0x13, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x13,
+	0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d,
+	0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x6c, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_notebooklm_v1alpha1_notebooklm_proto_rawDescOnce sync.Once
+	file_notebooklm_v1alpha1_notebooklm_proto_rawDescData = file_notebooklm_v1alpha1_notebooklm_proto_rawDesc
+)
+
+func file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP() []byte {
+	file_notebooklm_v1alpha1_notebooklm_proto_rawDescOnce.Do(func() {
+		file_notebooklm_v1alpha1_notebooklm_proto_rawDescData = protoimpl.X.CompressGZIP(file_notebooklm_v1alpha1_notebooklm_proto_rawDescData)
+	})
+	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescData
+}
+
+var file_notebooklm_v1alpha1_notebooklm_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_notebooklm_v1alpha1_notebooklm_proto_msgTypes = make([]protoimpl.MessageInfo, 111)
+var file_notebooklm_v1alpha1_notebooklm_proto_goTypes = []interface{}{
+	(ConversationalGoal)(0),                      // 0: notebooklm.v1alpha1.ConversationalGoal
+	(ResponseLength)(0),                          // 1: notebooklm.v1alpha1.ResponseLength
+	(ProjectRole)(0),                             // 2: notebooklm.v1alpha1.ProjectRole
+	(SourceType)(0),                              // 3: notebooklm.v1alpha1.SourceType
+	(ChatRole)(0),                                // 4: notebooklm.v1alpha1.ChatRole
+	(ProjectAccess)(0),                           // 5: notebooklm.v1alpha1.ProjectAccess
+	(AccountPlan)(0),                             // 6: notebooklm.v1alpha1.AccountPlan
+	(GuidebookStatus)(0),                         // 7: notebooklm.v1alpha1.GuidebookStatus
+	(ArtifactType)(0),                            // 8: notebooklm.v1alpha1.ArtifactType
+	(SourceSettings_SourceStatus)(0),             // 9: notebooklm.v1alpha1.SourceSettings.SourceStatus
+	(SourceIssue_Reason)(0),                      // 10: notebooklm.v1alpha1.SourceIssue.Reason
+	(*Project)(nil),                              // 11: notebooklm.v1alpha1.Project
+	(*ChatbotConfig)(nil),                        // 12: notebooklm.v1alpha1.ChatbotConfig
+	(*AdvancedSettings)(nil),                     // 13: notebooklm.v1alpha1.AdvancedSettings
+	(*ProjectMetadata)(nil),                      // 14: notebooklm.v1alpha1.ProjectMetadata
+	(*SourceId)(nil),                             // 15: notebooklm.v1alpha1.SourceId
+	(*Source)(nil),                               // 16: notebooklm.v1alpha1.Source
+	(*SourceMetadata)(nil),                       // 17: notebooklm.v1alpha1.SourceMetadata
+	(*GoogleDocsSourceMetadata)(nil),             // 18: notebooklm.v1alpha1.GoogleDocsSourceMetadata
+	(*YoutubeSourceMetadata)(nil),                // 19: notebooklm.v1alpha1.YoutubeSourceMetadata
+	(*SourceSettings)(nil),                       // 20: notebooklm.v1alpha1.SourceSettings
+	(*SourceIssue)(nil),                          // 21: notebooklm.v1alpha1.SourceIssue
+	(*GetNotesResponse)(nil),                     // 22: notebooklm.v1alpha1.GetNotesResponse
+	(*AudioOverviewResponse)(nil),                // 23: notebooklm.v1alpha1.AudioOverviewResponse
+	(*AudioOverview)(nil),                        // 24: notebooklm.v1alpha1.AudioOverview
+	(*GenerateDocumentGuidesResponse)(nil),       // 25: notebooklm.v1alpha1.GenerateDocumentGuidesResponse
+	(*DocumentGuide)(nil),                        // 26: notebooklm.v1alpha1.DocumentGuide
+	(*GenerateNotebookGuideResponse)(nil),        // 27: notebooklm.v1alpha1.GenerateNotebookGuideResponse
+	(*SuggestedQuestion)(nil),                    // 28: notebooklm.v1alpha1.SuggestedQuestion
+	(*GenerateOutlineResponse)(nil),              // 29: notebooklm.v1alpha1.GenerateOutlineResponse
+	(*GenerateSectionResponse)(nil),              // 30: notebooklm.v1alpha1.GenerateSectionResponse
+	(*GenerateArtifactResponse)(nil),             // 31: notebooklm.v1alpha1.GenerateArtifactResponse
+	(*GenerateMindMapResponse)(nil),              // 32: notebooklm.v1alpha1.GenerateMindMapResponse
+	(*MindMapNode)(nil),                          // 33: notebooklm.v1alpha1.MindMapNode
+	(*GenerateFlashcardsResponse)(nil),           // 34: notebooklm.v1alpha1.GenerateFlashcardsResponse
+	(*Flashcard)(nil),                            // 35: notebooklm.v1alpha1.Flashcard
+	(*GenerateQuizResponse)(nil),                 // 36: notebooklm.v1alpha1.GenerateQuizResponse
+	(*QuizQuestion)(nil),                         // 37: notebooklm.v1alpha1.QuizQuestion
+	(*StartDraftResponse)(nil),                   // 38: notebooklm.v1alpha1.StartDraftResponse
+	(*StartSectionResponse)(nil),                 // 39: notebooklm.v1alpha1.StartSectionResponse
+	(*ChatResponse)(nil),                         // 40: notebooklm.v1alpha1.ChatResponse
+	(*ChatAnswer)(nil),                           // 41: notebooklm.v1alpha1.ChatAnswer
+	(*Citation)(nil),                             // 42: notebooklm.v1alpha1.Citation
+	(*ChatTurn)(nil),                             // 43: notebooklm.v1alpha1.ChatTurn
+	(*GetChatHistoryResponse)(nil),               // 44: notebooklm.v1alpha1.GetChatHistoryResponse
+	(*ChatMessage)(nil),                          // 45: notebooklm.v1alpha1.ChatMessage
+	(*ListRecentlyViewedProjectsResponse)(nil),   // 46: notebooklm.v1alpha1.ListRecentlyViewedProjectsResponse
+	(*ShareAudioResponse)(nil),                   // 47: notebooklm.v1alpha1.ShareAudioResponse
+	(*ShareInfo)(nil),                            // 48: notebooklm.v1alpha1.ShareInfo
+	(*ProjectDetails)(nil),                       // 49: notebooklm.v1alpha1.ProjectDetails
+	(*Collaborator)(nil),                         // 50: notebooklm.v1alpha1.Collaborator
+	(*ShareProjectResponse)(nil),                 // 51: notebooklm.v1alpha1.ShareProjectResponse
+	(*AddSourcesResponse)(nil),                   // 52: notebooklm.v1alpha1.AddSourcesResponse
+	(*Account)(nil),                              // 53: notebooklm.v1alpha1.Account
+	(*AccountLimits)(nil),                        // 54: notebooklm.v1alpha1.AccountLimits
+	(*AccountSettings)(nil),                      // 55: notebooklm.v1alpha1.AccountSettings
+	(*ProjectAnalytics)(nil),                     // 56: notebooklm.v1alpha1.ProjectAnalytics
+	(*Guidebook)(nil),                            // 57: notebooklm.v1alpha1.Guidebook
+	(*GuidebookDetails)(nil),                     // 58: notebooklm.v1alpha1.GuidebookDetails
+	(*ListRecentlyViewedGuidebooksResponse)(nil), // 59: notebooklm.v1alpha1.ListRecentlyViewedGuidebooksResponse
+	(*ShareGuidebookResponse)(nil),               // 60: notebooklm.v1alpha1.ShareGuidebookResponse
+	(*GuidebookGenerateAnswerResponse)(nil),      // 61: notebooklm.v1alpha1.GuidebookGenerateAnswerResponse
+	(*LoadSourceResponse)(nil),                   // 62: notebooklm.v1alpha1.LoadSourceResponse
+	(*SourceContent)(nil),                        // 63: notebooklm.v1alpha1.SourceContent
+	(*CheckSourceFreshnessResponse)(nil),         // 64: notebooklm.v1alpha1.CheckSourceFreshnessResponse
+	(*ListRecentlyViewedProjectsRequest)(nil),    // 65: notebooklm.v1alpha1.ListRecentlyViewedProjectsRequest
+	(*CreateProjectRequest)(nil),                 // 66: notebooklm.v1alpha1.CreateProjectRequest
+	(*LoadNotebookRequest)(nil),                  // 67: notebooklm.v1alpha1.LoadNotebookRequest
+	(*DeleteProjectsRequest)(nil),                // 68: notebooklm.v1alpha1.DeleteProjectsRequest
+	(*MutateProjectRequest)(nil),                 // 69: notebooklm.v1alpha1.MutateProjectRequest
+	(*RemoveRecentlyViewedProjectRequest)(nil),   // 70: notebooklm.v1alpha1.RemoveRecentlyViewedProjectRequest
+	(*AddSourceRequest)(nil),                     // 71: notebooklm.v1alpha1.AddSourceRequest
+	(*SourceInput)(nil),                          // 72: notebooklm.v1alpha1.SourceInput
+	(*TextSourceInput)(nil),                      // 73: notebooklm.v1alpha1.TextSourceInput
+	(*WebSourceInput)(nil),                       // 74: notebooklm.v1alpha1.WebSourceInput
+	(*AddFileSourceRequest)(nil),                 // 75: notebooklm.v1alpha1.AddFileSourceRequest
+	(*FileSourceInput)(nil),                      // 76: notebooklm.v1alpha1.FileSourceInput
+	(*SourceIdList)(nil),                         // 77: notebooklm.v1alpha1.SourceIdList
+	(*DeleteSourcesRequest)(nil),                 // 78: notebooklm.v1alpha1.DeleteSourcesRequest
+	(*MutateSourceRequest)(nil),                  // 79: notebooklm.v1alpha1.MutateSourceRequest
+	(*RefreshSourceRequest)(nil),                 // 80: notebooklm.v1alpha1.RefreshSourceRequest
+	(*LoadSourceRequest)(nil),                    // 81: notebooklm.v1alpha1.LoadSourceRequest
+	(*CheckSourceFreshnessRequest)(nil),          // 82: notebooklm.v1alpha1.CheckSourceFreshnessRequest
+	(*ActOnSourcesRequest)(nil),                  // 83: notebooklm.v1alpha1.ActOnSourcesRequest
+	(*CreateNoteRequest)(nil),                    // 84: notebooklm.v1alpha1.CreateNoteRequest
+	(*MutateNoteRequest)(nil),                    // 85: notebooklm.v1alpha1.MutateNoteRequest
+	(*NoteUpdate)(nil),                           // 86: notebooklm.v1alpha1.NoteUpdate
+	(*NoteContent)(nil),                          // 87: notebooklm.v1alpha1.NoteContent
+	(*EmptyList)(nil),                            // 88: notebooklm.v1alpha1.EmptyList
+	(*DeleteNotesRequest)(nil),                   // 89: notebooklm.v1alpha1.DeleteNotesRequest
+	(*GetNotesRequest)(nil),                      // 90: notebooklm.v1alpha1.GetNotesRequest
+	(*CreateAudioOverviewRequest)(nil),           // 91: notebooklm.v1alpha1.CreateAudioOverviewRequest
+	(*GetAudioOverviewRequest)(nil),              // 92: notebooklm.v1alpha1.GetAudioOverviewRequest
+	(*DeleteAudioOverviewRequest)(nil),           // 93: notebooklm.v1alpha1.DeleteAudioOverviewRequest
+	(*GenerateDocumentGuidesRequest)(nil),        // 94: notebooklm.v1alpha1.GenerateDocumentGuidesRequest
+	(*GenerateNotebookGuideRequest)(nil),         // 95: notebooklm.v1alpha1.GenerateNotebookGuideRequest
+	(*GenerateOutlineRequest)(nil),               // 96: notebooklm.v1alpha1.GenerateOutlineRequest
+	(*GenerateSectionRequest)(nil),               // 97: notebooklm.v1alpha1.GenerateSectionRequest
+	(*GenerateArtifactRequest)(nil),              // 98: notebooklm.v1alpha1.GenerateArtifactRequest
+	(*GenerateMindMapRequest)(nil),               // 99: notebooklm.v1alpha1.GenerateMindMapRequest
+	(*GenerateFlashcardsRequest)(nil),            // 100: notebooklm.v1alpha1.GenerateFlashcardsRequest
+	(*GenerateQuizRequest)(nil),                  // 101: notebooklm.v1alpha1.GenerateQuizRequest
+	(*StartDraftRequest)(nil),                    // 102: notebooklm.v1alpha1.StartDraftRequest
+	(*StartSectionRequest)(nil),                  // 103: notebooklm.v1alpha1.StartSectionRequest
+	(*GetChatHistoryRequest)(nil),                // 104: notebooklm.v1alpha1.GetChatHistoryRequest
+	(*DeleteChatHistoryRequest)(nil),             // 105: notebooklm.v1alpha1.DeleteChatHistoryRequest
+	(*ChatRequest)(nil),                          // 106: notebooklm.v1alpha1.ChatRequest
+	(*GetOrCreateAccountRequest)(nil),            // 107: notebooklm.v1alpha1.GetOrCreateAccountRequest
+	(*MutateAccountRequest)(nil),                 // 108: notebooklm.v1alpha1.MutateAccountRequest
+	(*SubmitFeedbackRequest)(nil),                // 109: notebooklm.v1alpha1.SubmitFeedbackRequest
+	(*FeedbackContext)(nil),                      // 110: notebooklm.v1alpha1.FeedbackContext
+	(*GetProjectAnalyticsRequest)(nil),           // 111: notebooklm.v1alpha1.GetProjectAnalyticsRequest
+	(*GetProjectDetailsRequest)(nil),             // 112: notebooklm.v1alpha1.GetProjectDetailsRequest
+	(*ShareProjectRequest)(nil),                  // 113: notebooklm.v1alpha1.ShareProjectRequest
+	(*ListRecentlyViewedGuidebooksRequest)(nil),  // 114: notebooklm.v1alpha1.ListRecentlyViewedGuidebooksRequest
+	(*GetGuidebookRequest)(nil),                  // 115: notebooklm.v1alpha1.GetGuidebookRequest
+	(*GetGuidebookDetailsRequest)(nil),           // 116: notebooklm.v1alpha1.GetGuidebookDetailsRequest
+	(*PublishGuidebookRequest)(nil),              // 117: notebooklm.v1alpha1.PublishGuidebookRequest
+	(*ShareGuidebookRequest)(nil),                // 118: notebooklm.v1alpha1.ShareGuidebookRequest
+	(*DeleteGuidebookRequest)(nil),               // 119: notebooklm.v1alpha1.DeleteGuidebookRequest
+	(*GuidebookGenerateAnswerRequest)(nil),       // 120: notebooklm.v1alpha1.GuidebookGenerateAnswerRequest
+	(*ShareAudioRequest)(nil),                    // 121: notebooklm.v1alpha1.ShareAudioRequest
+	(*wrapperspb.StringValue)(nil),               // 122: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),                // 123: google.protobuf.Timestamp
+	(*wrapperspb.Int32Value)(nil),                // 124: google.protobuf.Int32Value
+	(*wrapperspb.BoolValue)(nil),                 // 125: google.protobuf.BoolValue
+	(*emptypb.Empty)(nil),                        // 126: google.protobuf.Empty
+}
+var file_notebooklm_v1alpha1_notebooklm_proto_depIdxs = []int32{
+	16,  // 0: notebooklm.v1alpha1.Project.sources:type_name -> notebooklm.v1alpha1.Source
+	14,  // 1: notebooklm.v1alpha1.Project.metadata:type_name -> notebooklm.v1alpha1.ProjectMetadata
+	12,  // 2: notebooklm.v1alpha1.Project.config:type_name -> notebooklm.v1alpha1.ChatbotConfig
+	13,  // 3: notebooklm.v1alpha1.Project.advanced_settings:type_name -> notebooklm.v1alpha1.AdvancedSettings
+	0,   // 4: notebooklm.v1alpha1.ChatbotConfig.goal:type_name -> notebooklm.v1alpha1.ConversationalGoal
+	1,   // 5: notebooklm.v1alpha1.ChatbotConfig.response_length:type_name -> notebooklm.v1alpha1.ResponseLength
+	122, // 6: notebooklm.v1alpha1.AdvancedSettings.output_language:type_name -> google.protobuf.StringValue
+	2,   // 7: notebooklm.v1alpha1.ProjectMetadata.user_role:type_name -> notebooklm.v1alpha1.ProjectRole
+	123, // 8: notebooklm.v1alpha1.ProjectMetadata.create_time:type_name -> google.protobuf.Timestamp
+	123, // 9: notebooklm.v1alpha1.ProjectMetadata.modified_time:type_name -> google.protobuf.Timestamp
+	15,  // 10: notebooklm.v1alpha1.Source.source_id:type_name -> notebooklm.v1alpha1.SourceId
+	17,  // 11: notebooklm.v1alpha1.Source.metadata:type_name -> notebooklm.v1alpha1.SourceMetadata
+	20,  // 12: notebooklm.v1alpha1.Source.settings:type_name -> notebooklm.v1alpha1.SourceSettings
+	124, // 13: notebooklm.v1alpha1.Source.warnings:type_name -> google.protobuf.Int32Value
+	18,  // 14: notebooklm.v1alpha1.SourceMetadata.google_docs:type_name -> notebooklm.v1alpha1.GoogleDocsSourceMetadata
+	19,  // 15: notebooklm.v1alpha1.SourceMetadata.youtube:type_name -> notebooklm.v1alpha1.YoutubeSourceMetadata
+	124, // 16: notebooklm.v1alpha1.SourceMetadata.last_update_time_seconds:type_name -> google.protobuf.Int32Value
+	123, // 17: notebooklm.v1alpha1.SourceMetadata.last_modified_time:type_name -> google.protobuf.Timestamp
+	3,   // 18: notebooklm.v1alpha1.SourceMetadata.source_type:type_name -> notebooklm.v1alpha1.SourceType
+	9,   // 19: notebooklm.v1alpha1.SourceSettings.status:type_name -> notebooklm.v1alpha1.SourceSettings.SourceStatus
+	10,  // 20: notebooklm.v1alpha1.SourceIssue.reason:type_name -> notebooklm.v1alpha1.SourceIssue.Reason
+	16,  // 21: notebooklm.v1alpha1.GetNotesResponse.notes:type_name -> notebooklm.v1alpha1.Source
+	24,  // 22: notebooklm.v1alpha1.AudioOverviewResponse.audio_overview:type_name -> notebooklm.v1alpha1.AudioOverview
+	26,  // 23: notebooklm.v1alpha1.GenerateDocumentGuidesResponse.guides:type_name -> notebooklm.v1alpha1.DocumentGuide
+	15,  // 24: notebooklm.v1alpha1.DocumentGuide.source_id:type_name -> notebooklm.v1alpha1.SourceId
+	28,  // 25: notebooklm.v1alpha1.GenerateNotebookGuideResponse.suggested_questions:type_name -> notebooklm.v1alpha1.SuggestedQuestion
+	33,  // 26: notebooklm.v1alpha1.GenerateMindMapResponse.root:type_name -> notebooklm.v1alpha1.MindMapNode
+	33,  // 27: notebooklm.v1alpha1.MindMapNode.children:type_name -> notebooklm.v1alpha1.MindMapNode
+	15,  // 28: notebooklm.v1alpha1.MindMapNode.source_ids:type_name -> notebooklm.v1alpha1.SourceId
+	35,  // 29: notebooklm.v1alpha1.GenerateFlashcardsResponse.flashcards:type_name -> notebooklm.v1alpha1.Flashcard
+	15,  // 30: notebooklm.v1alpha1.Flashcard.source_ids:type_name -> notebooklm.v1alpha1.SourceId
+	37,  // 31: notebooklm.v1alpha1.GenerateQuizResponse.questions:type_name -> notebooklm.v1alpha1.QuizQuestion
+	15,  // 32: notebooklm.v1alpha1.QuizQuestion.source_ids:type_name -> notebooklm.v1alpha1.SourceId
+	41,  // 33: notebooklm.v1alpha1.ChatResponse.answer:type_name -> notebooklm.v1alpha1.ChatAnswer
+	42,  // 34: notebooklm.v1alpha1.ChatAnswer.citations:type_name -> notebooklm.v1alpha1.Citation
+	15,  // 35: notebooklm.v1alpha1.Citation.source_id:type_name -> notebooklm.v1alpha1.SourceId
+	4,   // 36: notebooklm.v1alpha1.ChatTurn.role:type_name -> notebooklm.v1alpha1.ChatRole
+	45,  // 37: notebooklm.v1alpha1.GetChatHistoryResponse.messages:type_name -> notebooklm.v1alpha1.ChatMessage
+	4,   // 38: notebooklm.v1alpha1.ChatMessage.role:type_name -> notebooklm.v1alpha1.ChatRole
+	42,  // 39: notebooklm.v1alpha1.ChatMessage.citations:type_name -> notebooklm.v1alpha1.Citation
+	123, // 40: notebooklm.v1alpha1.ChatMessage.create_time:type_name -> google.protobuf.Timestamp
+	11,  // 41: notebooklm.v1alpha1.ListRecentlyViewedProjectsResponse.projects:type_name -> notebooklm.v1alpha1.Project
+	48,  // 42: notebooklm.v1alpha1.ShareAudioResponse.share_info:type_name -> notebooklm.v1alpha1.ShareInfo
+	5,   // 43: notebooklm.v1alpha1.ProjectDetails.access:type_name -> notebooklm.v1alpha1.ProjectAccess
+	50,  // 44: notebooklm.v1alpha1.ProjectDetails.collaborators:type_name -> notebooklm.v1alpha1.Collaborator
+	2,   // 45: notebooklm.v1alpha1.Collaborator.role:type_name -> notebooklm.v1alpha1.ProjectRole
+	5,   // 46: notebooklm.v1alpha1.ShareProjectResponse.access:type_name -> notebooklm.v1alpha1.ProjectAccess
+	16,  // 47: notebooklm.v1alpha1.AddSourcesResponse.sources:type_name -> notebooklm.v1alpha1.Source
+	6,   // 48: notebooklm.v1alpha1.Account.plan:type_name -> notebooklm.v1alpha1.AccountPlan
+	54,  // 49: notebooklm.v1alpha1.Account.limits:type_name -> notebooklm.v1alpha1.AccountLimits
+	55,  // 50: notebooklm.v1alpha1.Account.settings:type_name -> notebooklm.v1alpha1.AccountSettings
+	124, // 51: notebooklm.v1alpha1.AccountLimits.max_notebooks:type_name -> google.protobuf.Int32Value
+	124, // 52: notebooklm.v1alpha1.AccountLimits.max_sources_per_notebook:type_name -> google.protobuf.Int32Value
+	124, // 53: notebooklm.v1alpha1.AccountLimits.max_daily_audio_overviews:type_name -> google.protobuf.Int32Value
+	122, // 54: notebooklm.v1alpha1.AccountSettings.output_language:type_name -> google.protobuf.StringValue
+	125, // 55: notebooklm.v1alpha1.AccountSettings.email_notifications:type_name -> google.protobuf.BoolValue
+	124, // 56: notebooklm.v1alpha1.ProjectAnalytics.view_count:type_name -> google.protobuf.Int32Value
+	124, // 57: notebooklm.v1alpha1.ProjectAnalytics.unique_viewer_count:type_name -> google.protobuf.Int32Value
+	124, // 58: notebooklm.v1alpha1.ProjectAnalytics.chat_count:type_name -> google.protobuf.Int32Value
+	124, // 59: notebooklm.v1alpha1.ProjectAnalytics.share_count:type_name -> google.protobuf.Int32Value
+	123, // 60: notebooklm.v1alpha1.ProjectAnalytics.last_viewed_time:type_name -> google.protobuf.Timestamp
+	7,   // 61: notebooklm.v1alpha1.Guidebook.status:type_name -> notebooklm.v1alpha1.GuidebookStatus
+	123, // 62: notebooklm.v1alpha1.Guidebook.publish_time:type_name -> google.protobuf.Timestamp
+	57,  // 63: notebooklm.v1alpha1.GuidebookDetails.guidebook:type_name -> notebooklm.v1alpha1.Guidebook
+	5,   // 64: notebooklm.v1alpha1.GuidebookDetails.access:type_name -> notebooklm.v1alpha1.ProjectAccess
+	50,  // 65: notebooklm.v1alpha1.GuidebookDetails.collaborators:type_name -> notebooklm.v1alpha1.Collaborator
+	124, // 66: notebooklm.v1alpha1.GuidebookDetails.view_count:type_name -> google.protobuf.Int32Value
+	57,  // 67: notebooklm.v1alpha1.ListRecentlyViewedGuidebooksResponse.guidebooks:type_name -> notebooklm.v1alpha1.Guidebook
+	16,  // 68: notebooklm.v1alpha1.LoadSourceResponse.source:type_name -> notebooklm.v1alpha1.Source
+	63,  // 69: notebooklm.v1alpha1.LoadSourceResponse.content:type_name -> notebooklm.v1alpha1.SourceContent
+	123, // 70: notebooklm.v1alpha1.CheckSourceFreshnessResponse.last_update_time:type_name -> google.protobuf.Timestamp
+	11,  // 71: notebooklm.v1alpha1.MutateProjectRequest.updates:type_name -> notebooklm.v1alpha1.Project
+	72,  // 72: notebooklm.v1alpha1.AddSourceRequest.sources:type_name -> notebooklm.v1alpha1.SourceInput
+	73,  // 73: notebooklm.v1alpha1.SourceInput.text:type_name -> notebooklm.v1alpha1.TextSourceInput
+	74,  // 74: notebooklm.v1alpha1.SourceInput.web:type_name -> notebooklm.v1alpha1.WebSourceInput
+	76,  // 75: notebooklm.v1alpha1.AddFileSourceRequest.sources:type_name -> notebooklm.v1alpha1.FileSourceInput
+	77,  // 76: notebooklm.v1alpha1.DeleteSourcesRequest.sources:type_name -> notebooklm.v1alpha1.SourceIdList
+	16,  // 77: notebooklm.v1alpha1.MutateSourceRequest.updates:type_name -> notebooklm.v1alpha1.Source
+	86,  // 78: notebooklm.v1alpha1.MutateNoteRequest.updates:type_name -> notebooklm.v1alpha1.NoteUpdate
+	87,  // 79: notebooklm.v1alpha1.NoteUpdate.note:type_name -> notebooklm.v1alpha1.NoteContent
+	88,  // 80: notebooklm.v1alpha1.NoteContent.unknown:type_name -> notebooklm.v1alpha1.EmptyList
+	77,  // 81: notebooklm.v1alpha1.DeleteNotesRequest.notes:type_name -> notebooklm.v1alpha1.SourceIdList
+	77,  // 82: notebooklm.v1alpha1.CreateAudioOverviewRequest.sources:type_name -> notebooklm.v1alpha1.SourceIdList
+	77,  // 83: notebooklm.v1alpha1.GenerateNotebookGuideRequest.sources:type_name -> notebooklm.v1alpha1.SourceIdList
+	77,  // 84: notebooklm.v1alpha1.GenerateOutlineRequest.sources:type_name -> notebooklm.v1alpha1.SourceIdList
+	77,  // 85: notebooklm.v1alpha1.GenerateSectionRequest.sources:type_name -> notebooklm.v1alpha1.SourceIdList
+	8,   // 86: notebooklm.v1alpha1.GenerateArtifactRequest.type:type_name -> notebooklm.v1alpha1.ArtifactType
+	77,  // 87: notebooklm.v1alpha1.GenerateArtifactRequest.sources:type_name -> notebooklm.v1alpha1.SourceIdList
+	77,  // 88: notebooklm.v1alpha1.GenerateMindMapRequest.sources:type_name -> notebooklm.v1alpha1.SourceIdList
+	77,  // 89: notebooklm.v1alpha1.GenerateFlashcardsRequest.sources:type_name -> notebooklm.v1alpha1.SourceIdList
+	77,  // 90: notebooklm.v1alpha1.GenerateQuizRequest.sources:type_name -> notebooklm.v1alpha1.SourceIdList
+	77,  // 91: notebooklm.v1alpha1.ChatRequest.sources:type_name -> notebooklm.v1alpha1.SourceIdList
+	43,  // 92: notebooklm.v1alpha1.ChatRequest.history:type_name -> notebooklm.v1alpha1.ChatTurn
+	55,  // 93: notebooklm.v1alpha1.MutateAccountRequest.settings:type_name -> notebooklm.v1alpha1.AccountSettings
+	110, // 94: notebooklm.v1alpha1.SubmitFeedbackRequest.context:type_name -> notebooklm.v1alpha1.FeedbackContext
+	5,   // 95: notebooklm.v1alpha1.ShareProjectRequest.access:type_name -> notebooklm.v1alpha1.ProjectAccess
+	50,  // 96: notebooklm.v1alpha1.ShareProjectRequest.collaborators:type_name -> notebooklm.v1alpha1.Collaborator
+	5,   // 97: notebooklm.v1alpha1.ShareGuidebookRequest.access:type_name -> notebooklm.v1alpha1.ProjectAccess
+	50,  // 98: notebooklm.v1alpha1.ShareGuidebookRequest.collaborators:type_name -> notebooklm.v1alpha1.Collaborator
+	65,  // 99: notebooklm.v1alpha1.NotebookLM.ListRecentlyViewedProjects:input_type -> notebooklm.v1alpha1.ListRecentlyViewedProjectsRequest
+	66,  // 100: notebooklm.v1alpha1.NotebookLM.CreateProject:input_type -> notebooklm.v1alpha1.CreateProjectRequest
+	67,  // 101: notebooklm.v1alpha1.NotebookLM.GetProject:input_type -> notebooklm.v1alpha1.LoadNotebookRequest
+	68,  // 102: notebooklm.v1alpha1.NotebookLM.DeleteProjects:input_type -> notebooklm.v1alpha1.DeleteProjectsRequest
+	69,  // 103: notebooklm.v1alpha1.NotebookLM.MutateProject:input_type -> notebooklm.v1alpha1.MutateProjectRequest
+	70,  // 104: notebooklm.v1alpha1.NotebookLM.RemoveRecentlyViewedProject:input_type -> notebooklm.v1alpha1.RemoveRecentlyViewedProjectRequest
+	71,  // 105: notebooklm.v1alpha1.NotebookLM.AddSources:input_type -> notebooklm.v1alpha1.AddSourceRequest
+	75,  // 106: notebooklm.v1alpha1.NotebookLM.AddFileSources:input_type -> notebooklm.v1alpha1.AddFileSourceRequest
+	78,  // 107: notebooklm.v1alpha1.NotebookLM.DeleteSources:input_type -> notebooklm.v1alpha1.DeleteSourcesRequest
+	79,  // 108: notebooklm.v1alpha1.NotebookLM.MutateSource:input_type -> notebooklm.v1alpha1.MutateSourceRequest
+	80,  // 109: notebooklm.v1alpha1.NotebookLM.RefreshSource:input_type -> notebooklm.v1alpha1.RefreshSourceRequest
+	81,  // 110: notebooklm.v1alpha1.NotebookLM.LoadSource:input_type -> notebooklm.v1alpha1.LoadSourceRequest
+	82,  // 111: notebooklm.v1alpha1.NotebookLM.CheckSourceFreshness:input_type -> notebooklm.v1alpha1.CheckSourceFreshnessRequest
+	83,  // 112: notebooklm.v1alpha1.NotebookLM.ActOnSources:input_type -> notebooklm.v1alpha1.ActOnSourcesRequest
+	84,  // 113: notebooklm.v1alpha1.NotebookLM.CreateNote:input_type -> notebooklm.v1alpha1.CreateNoteRequest
+	85,  // 114: notebooklm.v1alpha1.NotebookLM.MutateNote:input_type -> notebooklm.v1alpha1.MutateNoteRequest
+	89,  // 115: notebooklm.v1alpha1.NotebookLM.DeleteNotes:input_type -> notebooklm.v1alpha1.DeleteNotesRequest
+	90,  // 116: notebooklm.v1alpha1.NotebookLM.GetNotes:input_type -> notebooklm.v1alpha1.GetNotesRequest
+	91,  // 117: notebooklm.v1alpha1.NotebookLM.CreateAudioOverview:input_type -> notebooklm.v1alpha1.CreateAudioOverviewRequest
+	92,  // 118: notebooklm.v1alpha1.NotebookLM.GetAudioOverview:input_type -> notebooklm.v1alpha1.GetAudioOverviewRequest
+	93,  // 119: notebooklm.v1alpha1.NotebookLM.DeleteAudioOverview:input_type -> notebooklm.v1alpha1.DeleteAudioOverviewRequest
+	94,  // 120: notebooklm.v1alpha1.NotebookLM.GenerateDocumentGuides:input_type -> notebooklm.v1alpha1.GenerateDocumentGuidesRequest
+	95,  // 121: notebooklm.v1alpha1.NotebookLM.GenerateNotebookGuide:input_type -> notebooklm.v1alpha1.GenerateNotebookGuideRequest
+	96,  // 122: notebooklm.v1alpha1.NotebookLM.GenerateOutline:input_type -> notebooklm.v1alpha1.GenerateOutlineRequest
+	97,  // 123: notebooklm.v1alpha1.NotebookLM.GenerateSection:input_type -> notebooklm.v1alpha1.GenerateSectionRequest
+	98,  // 124: notebooklm.v1alpha1.NotebookLM.GenerateArtifact:input_type -> notebooklm.v1alpha1.GenerateArtifactRequest
+	99,  // 125: notebooklm.v1alpha1.NotebookLM.GenerateMindMap:input_type -> notebooklm.v1alpha1.GenerateMindMapRequest
+	100, // 126: notebooklm.v1alpha1.NotebookLM.GenerateFlashcards:input_type -> notebooklm.v1alpha1.GenerateFlashcardsRequest
+	101, // 127: notebooklm.v1alpha1.NotebookLM.GenerateQuiz:input_type -> notebooklm.v1alpha1.GenerateQuizRequest
+	102, // 128: notebooklm.v1alpha1.NotebookLM.StartDraft:input_type -> notebooklm.v1alpha1.StartDraftRequest
+	103, // 129: notebooklm.v1alpha1.NotebookLM.StartSection:input_type -> notebooklm.v1alpha1.StartSectionRequest
+	104, // 130: notebooklm.v1alpha1.NotebookLM.GetChatHistory:input_type -> notebooklm.v1alpha1.GetChatHistoryRequest
+	105, // 131: notebooklm.v1alpha1.NotebookLM.DeleteChatHistory:input_type -> notebooklm.v1alpha1.DeleteChatHistoryRequest
+	107, // 132: notebooklm.v1alpha1.NotebookLM.GetOrCreateAccount:input_type -> notebooklm.v1alpha1.GetOrCreateAccountRequest
+	108, // 133: notebooklm.v1alpha1.NotebookLM.MutateAccount:input_type -> notebooklm.v1alpha1.MutateAccountRequest
+	111, // 134: notebooklm.v1alpha1.NotebookLM.GetProjectAnalytics:input_type -> notebooklm.v1alpha1.GetProjectAnalyticsRequest
+	109, // 135: notebooklm.v1alpha1.NotebookLM.SubmitFeedback:input_type -> notebooklm.v1alpha1.SubmitFeedbackRequest
+	121, // 136: notebooklm.v1alpha1.NotebookLMSharing.ShareAudio:input_type -> notebooklm.v1alpha1.ShareAudioRequest
+	112, // 137: notebooklm.v1alpha1.NotebookLMSharing.GetProjectDetails:input_type -> notebooklm.v1alpha1.GetProjectDetailsRequest
+	113, // 138: notebooklm.v1alpha1.NotebookLMSharing.ShareProject:input_type -> notebooklm.v1alpha1.ShareProjectRequest
+	119, // 139: notebooklm.v1alpha1.NotebookLMGuidebooks.DeleteGuidebook:input_type -> notebooklm.v1alpha1.DeleteGuidebookRequest
+	115, // 140: notebooklm.v1alpha1.NotebookLMGuidebooks.GetGuidebook:input_type -> notebooklm.v1alpha1.GetGuidebookRequest
+	114, // 141: notebooklm.v1alpha1.NotebookLMGuidebooks.ListRecentlyViewedGuidebooks:input_type -> notebooklm.v1alpha1.ListRecentlyViewedGuidebooksRequest
+	117, // 142: notebooklm.v1alpha1.NotebookLMGuidebooks.PublishGuidebook:input_type -> notebooklm.v1alpha1.PublishGuidebookRequest
+	116, // 143: notebooklm.v1alpha1.NotebookLMGuidebooks.GetGuidebookDetails:input_type -> notebooklm.v1alpha1.GetGuidebookDetailsRequest
+	118, // 144: notebooklm.v1alpha1.NotebookLMGuidebooks.ShareGuidebook:input_type -> notebooklm.v1alpha1.ShareGuidebookRequest
+	120, // 145: notebooklm.v1alpha1.NotebookLMGuidebooks.GuidebookGenerateAnswer:input_type -> notebooklm.v1alpha1.GuidebookGenerateAnswerRequest
+	46,  // 146: notebooklm.v1alpha1.NotebookLM.ListRecentlyViewedProjects:output_type -> notebooklm.v1alpha1.ListRecentlyViewedProjectsResponse
+	11,  // 147: notebooklm.v1alpha1.NotebookLM.CreateProject:output_type -> notebooklm.v1alpha1.Project
+	11,  // 148: notebooklm.v1alpha1.NotebookLM.GetProject:output_type -> notebooklm.v1alpha1.Project
+	126, // 149: notebooklm.v1alpha1.NotebookLM.DeleteProjects:output_type -> google.protobuf.Empty
+	11,  // 150: notebooklm.v1alpha1.NotebookLM.MutateProject:output_type -> notebooklm.v1alpha1.Project
+	126, // 151: notebooklm.v1alpha1.NotebookLM.RemoveRecentlyViewedProject:output_type -> google.protobuf.Empty
+	52,  // 152: notebooklm.v1alpha1.NotebookLM.AddSources:output_type -> notebooklm.v1alpha1.AddSourcesResponse
+	52,  // 153: notebooklm.v1alpha1.NotebookLM.AddFileSources:output_type -> notebooklm.v1alpha1.AddSourcesResponse
+	126, // 154: notebooklm.v1alpha1.NotebookLM.DeleteSources:output_type -> google.protobuf.Empty
+	16,  // 155: notebooklm.v1alpha1.NotebookLM.MutateSource:output_type -> notebooklm.v1alpha1.Source
+	16,  // 156: notebooklm.v1alpha1.NotebookLM.RefreshSource:output_type -> notebooklm.v1alpha1.Source
+	62,  // 157: notebooklm.v1alpha1.NotebookLM.LoadSource:output_type -> notebooklm.v1alpha1.LoadSourceResponse
+	64,  // 158: notebooklm.v1alpha1.NotebookLM.CheckSourceFreshness:output_type -> notebooklm.v1alpha1.CheckSourceFreshnessResponse
+	126, // 159: notebooklm.v1alpha1.NotebookLM.ActOnSources:output_type -> google.protobuf.Empty
+	16,  // 160: notebooklm.v1alpha1.NotebookLM.CreateNote:output_type -> notebooklm.v1alpha1.Source
+	16,  // 161: notebooklm.v1alpha1.NotebookLM.MutateNote:output_type -> notebooklm.v1alpha1.Source
+	126, // 162: notebooklm.v1alpha1.NotebookLM.DeleteNotes:output_type -> google.protobuf.Empty
+	22,  // 163: notebooklm.v1alpha1.NotebookLM.GetNotes:output_type -> notebooklm.v1alpha1.GetNotesResponse
+	23,  // 164: notebooklm.v1alpha1.NotebookLM.CreateAudioOverview:output_type -> notebooklm.v1alpha1.AudioOverviewResponse
+	23,  // 165: notebooklm.v1alpha1.NotebookLM.GetAudioOverview:output_type -> notebooklm.v1alpha1.AudioOverviewResponse
+	126, // 166: notebooklm.v1alpha1.NotebookLM.DeleteAudioOverview:output_type -> google.protobuf.Empty
+	25,  // 167: notebooklm.v1alpha1.NotebookLM.GenerateDocumentGuides:output_type -> notebooklm.v1alpha1.GenerateDocumentGuidesResponse
+	27,  // 168: notebooklm.v1alpha1.NotebookLM.GenerateNotebookGuide:output_type -> notebooklm.v1alpha1.GenerateNotebookGuideResponse
+	29,  // 169: notebooklm.v1alpha1.NotebookLM.GenerateOutline:output_type -> notebooklm.v1alpha1.GenerateOutlineResponse
+	30,  // 170: notebooklm.v1alpha1.NotebookLM.GenerateSection:output_type -> notebooklm.v1alpha1.GenerateSectionResponse
+	31,  // 171: notebooklm.v1alpha1.NotebookLM.GenerateArtifact:output_type -> notebooklm.v1alpha1.GenerateArtifactResponse
+	32,  // 172: notebooklm.v1alpha1.NotebookLM.GenerateMindMap:output_type -> notebooklm.v1alpha1.GenerateMindMapResponse
+	34,  // 173: notebooklm.v1alpha1.NotebookLM.GenerateFlashcards:output_type -> notebooklm.v1alpha1.GenerateFlashcardsResponse
+	36,  // 174: notebooklm.v1alpha1.NotebookLM.GenerateQuiz:output_type -> notebooklm.v1alpha1.GenerateQuizResponse
+	38,  // 175: notebooklm.v1alpha1.NotebookLM.StartDraft:output_type -> notebooklm.v1alpha1.StartDraftResponse
+	39,  // 176: notebooklm.v1alpha1.NotebookLM.StartSection:output_type -> notebooklm.v1alpha1.StartSectionResponse
+	44,  // 177: notebooklm.v1alpha1.NotebookLM.GetChatHistory:output_type -> notebooklm.v1alpha1.GetChatHistoryResponse
+	126, // 178: notebooklm.v1alpha1.NotebookLM.DeleteChatHistory:output_type -> google.protobuf.Empty
+	53,  // 179: notebooklm.v1alpha1.NotebookLM.GetOrCreateAccount:output_type -> notebooklm.v1alpha1.Account
+	53,  // 180: notebooklm.v1alpha1.NotebookLM.MutateAccount:output_type -> notebooklm.v1alpha1.Account
+	56,  // 181: notebooklm.v1alpha1.NotebookLM.GetProjectAnalytics:output_type -> notebooklm.v1alpha1.ProjectAnalytics
+	126, // 182: notebooklm.v1alpha1.NotebookLM.SubmitFeedback:output_type -> google.protobuf.Empty
+	47,  // 183: notebooklm.v1alpha1.NotebookLMSharing.ShareAudio:output_type -> notebooklm.v1alpha1.ShareAudioResponse
+	49,  // 184: notebooklm.v1alpha1.NotebookLMSharing.GetProjectDetails:output_type -> notebooklm.v1alpha1.ProjectDetails
+	51,  // 185: notebooklm.v1alpha1.NotebookLMSharing.ShareProject:output_type -> notebooklm.v1alpha1.ShareProjectResponse
+	126, // 186: notebooklm.v1alpha1.NotebookLMGuidebooks.DeleteGuidebook:output_type -> google.protobuf.Empty
+	57,  // 187: notebooklm.v1alpha1.NotebookLMGuidebooks.GetGuidebook:output_type -> notebooklm.v1alpha1.Guidebook
+	59,  // 188: notebooklm.v1alpha1.NotebookLMGuidebooks.ListRecentlyViewedGuidebooks:output_type -> notebooklm.v1alpha1.ListRecentlyViewedGuidebooksResponse
+	57,  // 189: notebooklm.v1alpha1.NotebookLMGuidebooks.PublishGuidebook:output_type -> notebooklm.v1alpha1.Guidebook
+	58,  // 190: notebooklm.v1alpha1.NotebookLMGuidebooks.GetGuidebookDetails:output_type -> notebooklm.v1alpha1.GuidebookDetails
+	60,  // 191: notebooklm.v1alpha1.NotebookLMGuidebooks.ShareGuidebook:output_type -> notebooklm.v1alpha1.ShareGuidebookResponse
+	61,  // 192: notebooklm.v1alpha1.NotebookLMGuidebooks.GuidebookGenerateAnswer:output_type -> notebooklm.v1alpha1.GuidebookGenerateAnswerResponse
+	146, // [146:193] is the sub-list for method output_type
+	99,  // [99:146] is the sub-list for method input_type
+	99,  // [99:99] is the sub-list for extension type_name
+	99,  // [99:99] is the sub-list for extension extendee
+	0,   // [0:99] is the sub-list for field type_name
+}
+
+func init() { file_notebooklm_v1alpha1_notebooklm_proto_init() }
+func file_notebooklm_v1alpha1_notebooklm_proto_init() {
+	if File_notebooklm_v1alpha1_notebooklm_proto != nil {
+		return
+	}
+	file_notebooklm_v1alpha1_rpc_extensions_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Project); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatbotConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdvancedSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Source); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoogleDocsSourceMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*YoutubeSourceMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNotesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AudioOverviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AudioOverview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateDocumentGuidesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentGuide); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateNotebookGuideResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestedQuestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateOutlineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateSectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateArtifactResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateMindMapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MindMapNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateFlashcardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Flashcard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateQuizResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuizQuestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartDraftResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Citation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatTurn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChatHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRecentlyViewedProjectsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareAudioResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Collaborator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSourcesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectAnalytics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Guidebook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuidebookDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRecentlyViewedGuidebooksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareGuidebookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuidebookGenerateAnswerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadSourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceContent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSourceFreshnessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRecentlyViewedProjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadNotebookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MutateProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveRecentlyViewedProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextSourceInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebSourceInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFileSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileSourceInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceIdList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MutateSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSourceFreshnessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActOnSourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateNoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MutateNoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NoteUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NoteContent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteNotesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNotesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAudioOverviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAudioOverviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAudioOverviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateDocumentGuidesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateNotebookGuideRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateOutlineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StartSectionResponse.ProtoReflect.Descriptor instead.
-func (*StartSectionResponse) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{17}
-}
-
-type ListRecentlyViewedProjectsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Projects []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
-}
-
-func (x *ListRecentlyViewedProjectsResponse) Reset() {
-	*x = ListRecentlyViewedProjectsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListRecentlyViewedProjectsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListRecentlyViewedProjectsResponse) ProtoMessage() {}
-
-func (x *ListRecentlyViewedProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateSectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListRecentlyViewedProjectsResponse.ProtoReflect.Descriptor instead.
-func (*ListRecentlyViewedProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *ListRecentlyViewedProjectsResponse) GetProjects() []*Project {
-	if x != nil {
-		return x.Projects
-	}
-	return nil
-}
-
-var File_notebooklm_v1alpha1_notebooklm_proto protoreflect.FileDescriptor
-
-var file_notebooklm_v1alpha1_notebooklm_proto_rawDesc = []byte{
-	0x0a, 0x24, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2f, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
-	0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61,
-	0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcd, 0x01, 0x0a,
-	0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x35,
-	0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e,
-	0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x86, 0x02, 0x0a,
-	0x0f, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x25, 0x0a,
-	0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61,
-	0x72, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x74,
-	0x61, 0x72, 0x72, 0x65, 0x64, 0x22, 0x27, 0x0a, 0x08, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0x95,
-	0x02, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e,
-	0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x52, 0x08, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x08,
-	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
-	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x37, 0x0a,
-	0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x77, 0x61,
-	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x9d, 0x03, 0x0a, 0x0e, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x50, 0x0a, 0x0b, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
-	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x63, 0x73, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
-	0x0a, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x79,
-	0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e,
-	0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x59, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x07, 0x79, 0x6f, 0x75, 0x74,
-	0x75, 0x62, 0x65, 0x12, 0x54, 0x0a, 0x18, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x48, 0x0a, 0x12, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62,
-	0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3b, 0x0a, 0x18, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x44, 0x6f, 0x63, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x15, 0x59, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b,
-	0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a,
-	0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x22, 0xd9, 0x01, 0x0a, 0x0e, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x48, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x6e, 0x6f,
-	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7d, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12,
-	0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x53,
-	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0x03, 0x22, 0xf0, 0x04, 0x0a, 0x0b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
-	0x73, 0x73, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c,
-	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9f, 0x04, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x41, 0x53,
-	0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4f, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x53, 0x45, 0x52,
-	0x56, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x52,
-	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x41, 0x4e, 0x45, 0x4e, 0x54, 0x5f,
-	0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x1c,
-	0x0a, 0x18, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
-	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x49, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17,
-	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4e, 0x4f,
-	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x41,
-	0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f,
-	0x4d, 0x49, 0x4d, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x52,
-	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x59, 0x4f, 0x55, 0x54, 0x55, 0x42, 0x45, 0x5f, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x49, 0x43, 0x10, 0x06, 0x12, 0x21, 0x0a,
-	0x1d, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x59, 0x4f, 0x55, 0x54, 0x55, 0x42, 0x45, 0x5f,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x44, 0x10, 0x07,
-	0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x59, 0x4f, 0x55, 0x54, 0x55,
-	0x42, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45,
-	0x10, 0x08, 0x12, 0x25, 0x0a, 0x21, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x59, 0x4f, 0x55,
-	0x54, 0x55, 0x42, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45,
-	0x52, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x09, 0x12, 0x27, 0x0a, 0x23, 0x52, 0x45, 0x41,
-	0x53, 0x4f, 0x4e, 0x5f, 0x59, 0x4f, 0x55, 0x54, 0x55, 0x42, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
-	0x10, 0x0a, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x47, 0x4f, 0x4f,
-	0x47, 0x4c, 0x45, 0x5f, 0x44, 0x4f, 0x43, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x47,
-	0x45, 0x4e, 0x45, 0x52, 0x49, 0x43, 0x10, 0x0b, 0x12, 0x26, 0x0a, 0x22, 0x52, 0x45, 0x41, 0x53,
-	0x4f, 0x4e, 0x5f, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45, 0x5f, 0x44, 0x4f, 0x43, 0x53, 0x5f, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x0c,
-	0x12, 0x24, 0x0a, 0x20, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x47, 0x4f, 0x4f, 0x47, 0x4c,
-	0x45, 0x5f, 0x44, 0x4f, 0x43, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x0d, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
-	0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52,
-	0x45, 0x10, 0x0e, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x0f, 0x22, 0x45, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e, 0x6f,
-	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x6e,
-	0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74,
-	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x65,
-	0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5c, 0x0a, 0x1e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x75, 0x69, 0x64, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x67, 0x75, 0x69, 0x64, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
-	0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x6f,
-	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x75, 0x69, 0x64, 0x65, 0x52, 0x06, 0x67, 0x75, 0x69,
-	0x64, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x0d, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x47,
-	0x75, 0x69, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x39,
-	0x0a, 0x1d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
-	0x6f, 0x6b, 0x47, 0x75, 0x69, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x33, 0x0a, 0x17, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x33,
-	0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x72, 0x61, 0x66,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x5e, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c,
-	0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
-	0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x2a, 0x8f, 0x02, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a,
-	0x13, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45, 0x5f, 0x44, 0x4f, 0x43,
-	0x53, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45, 0x5f, 0x53, 0x4c, 0x49, 0x44, 0x45, 0x53,
-	0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45, 0x5f, 0x53, 0x48, 0x45, 0x45, 0x54, 0x53, 0x10,
-	0x05, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x06, 0x12, 0x18, 0x0a,
-	0x14, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x45, 0x42,
-	0x5f, 0x50, 0x41, 0x47, 0x45, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4f, 0x55, 0x52, 0x43,
-	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x5f, 0x4e, 0x4f,
-	0x54, 0x45, 0x10, 0x08, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x59, 0x4f, 0x55, 0x54, 0x55, 0x42, 0x45, 0x5f, 0x56, 0x49, 0x44, 0x45,
-	0x4f, 0x10, 0x09, 0x42, 0xdc, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
-	0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42,
-	0x0f, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
-	0x6d, 0x63, 0x2f, 0x6e, 0x6c, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x3b, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x58, 0x58, 0xaa, 0x02, 0x13,
-	0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0xca, 0x02, 0x13, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d,
-	0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x4e, 0x6f, 0x74, 0x65,
-	0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x4e, 0x6f,
-	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x6c, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_notebooklm_v1alpha1_notebooklm_proto_rawDescOnce sync.Once
-	file_notebooklm_v1alpha1_notebooklm_proto_rawDescData = file_notebooklm_v1alpha1_notebooklm_proto_rawDesc
-)
-
-func file_notebooklm_v1alpha1_notebooklm_proto_rawDescGZIP() []byte {
-	file_notebooklm_v1alpha1_notebooklm_proto_rawDescOnce.Do(func() {
-		file_notebooklm_v1alpha1_notebooklm_proto_rawDescData = protoimpl.X.CompressGZIP(file_notebooklm_v1alpha1_notebooklm_proto_rawDescData)
-	})
-	return file_notebooklm_v1alpha1_notebooklm_proto_rawDescData
-}
-
-var file_notebooklm_v1alpha1_notebooklm_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_notebooklm_v1alpha1_notebooklm_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
-var file_notebooklm_v1alpha1_notebooklm_proto_goTypes = []interface{}{
-	(SourceType)(0),                            // 0: notebooklm.v1alpha1.SourceType
-	(SourceSettings_SourceStatus)(0),           // 1: notebooklm.v1alpha1.SourceSettings.SourceStatus
-	(SourceIssue_Reason)(0),                    // 2: notebooklm.v1alpha1.SourceIssue.Reason
-	(*Project)(nil),                            // 3: notebooklm.v1alpha1.Project
-	(*ProjectMetadata)(nil),                    // 4: notebooklm.v1alpha1.ProjectMetadata
-	(*SourceId)(nil),                           // 5: notebooklm.v1alpha1.SourceId
-	(*Source)(nil),                             // 6: notebooklm.v1alpha1.Source
-	(*SourceMetadata)(nil),                     // 7: notebooklm.v1alpha1.SourceMetadata
-	(*GoogleDocsSourceMetadata)(nil),           // 8: notebooklm.v1alpha1.GoogleDocsSourceMetadata
-	(*YoutubeSourceMetadata)(nil),              // 9: notebooklm.v1alpha1.YoutubeSourceMetadata
-	(*SourceSettings)(nil),                     // 10: notebooklm.v1alpha1.SourceSettings
-	(*SourceIssue)(nil),                        // 11: notebooklm.v1alpha1.SourceIssue
-	(*GetNotesResponse)(nil),                   // 12: notebooklm.v1alpha1.GetNotesResponse
-	(*AudioOverview)(nil),                      // 13: notebooklm.v1alpha1.AudioOverview
-	(*GenerateDocumentGuidesResponse)(nil),     // 14: notebooklm.v1alpha1.GenerateDocumentGuidesResponse
-	(*DocumentGuide)(nil),                      // 15: notebooklm.v1alpha1.DocumentGuide
-	(*GenerateNotebookGuideResponse)(nil),      // 16: notebooklm.v1alpha1.GenerateNotebookGuideResponse
-	(*GenerateOutlineResponse)(nil),            // 17: notebooklm.v1alpha1.GenerateOutlineResponse
-	(*GenerateSectionResponse)(nil),            // 18: notebooklm.v1alpha1.GenerateSectionResponse
-	(*StartDraftResponse)(nil),                 // 19: notebooklm.v1alpha1.StartDraftResponse
-	(*StartSectionResponse)(nil),               // 20: notebooklm.v1alpha1.StartSectionResponse
-	(*ListRecentlyViewedProjectsResponse)(nil), // 21: notebooklm.v1alpha1.ListRecentlyViewedProjectsResponse
-	(*timestamppb.Timestamp)(nil),              // 22: google.protobuf.Timestamp
-	(*wrapperspb.Int32Value)(nil),              // 23: google.protobuf.Int32Value
-}
-var file_notebooklm_v1alpha1_notebooklm_proto_depIdxs = []int32{
-	6,  // 0: notebooklm.v1alpha1.Project.sources:type_name -> notebooklm.v1alpha1.Source
-	4,  // 1: notebooklm.v1alpha1.Project.metadata:type_name -> notebooklm.v1alpha1.ProjectMetadata
-	22, // 2: notebooklm.v1alpha1.ProjectMetadata.create_time:type_name -> google.protobuf.Timestamp
-	22, // 3: notebooklm.v1alpha1.ProjectMetadata.modified_time:type_name -> google.protobuf.Timestamp
-	5,  // 4: notebooklm.v1alpha1.Source.source_id:type_name -> notebooklm.v1alpha1.SourceId
-	7,  // 5: notebooklm.v1alpha1.Source.metadata:type_name -> notebooklm.v1alpha1.SourceMetadata
-	10, // 6: notebooklm.v1alpha1.Source.settings:type_name -> notebooklm.v1alpha1.SourceSettings
-	23, // 7: notebooklm.v1alpha1.Source.warnings:type_name -> google.protobuf.Int32Value
-	8,  // 8: notebooklm.v1alpha1.SourceMetadata.google_docs:type_name -> notebooklm.v1alpha1.GoogleDocsSourceMetadata
-	9,  // 9: notebooklm.v1alpha1.SourceMetadata.youtube:type_name -> notebooklm.v1alpha1.YoutubeSourceMetadata
-	23, // 10: notebooklm.v1alpha1.SourceMetadata.last_update_time_seconds:type_name -> google.protobuf.Int32Value
-	22, // 11: notebooklm.v1alpha1.SourceMetadata.last_modified_time:type_name -> google.protobuf.Timestamp
-	0,  // 12: notebooklm.v1alpha1.SourceMetadata.source_type:type_name -> notebooklm.v1alpha1.SourceType
-	1,  // 13: notebooklm.v1alpha1.SourceSettings.status:type_name -> notebooklm.v1alpha1.SourceSettings.SourceStatus
-	2,  // 14: notebooklm.v1alpha1.SourceIssue.reason:type_name -> notebooklm.v1alpha1.SourceIssue.Reason
-	6,  // 15: notebooklm.v1alpha1.GetNotesResponse.notes:type_name -> notebooklm.v1alpha1.Source
-	15, // 16: notebooklm.v1alpha1.GenerateDocumentGuidesResponse.guides:type_name -> notebooklm.v1alpha1.DocumentGuide
-	3,  // 17: notebooklm.v1alpha1.ListRecentlyViewedProjectsResponse.projects:type_name -> notebooklm.v1alpha1.Project
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
-}
-
-func init() { file_notebooklm_v1alpha1_notebooklm_proto_init() }
-func file_notebooklm_v1alpha1_notebooklm_proto_init() {
-	if File_notebooklm_v1alpha1_notebooklm_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Project); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateArtifactRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1601,8 +9602,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProjectMetadata); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateMindMapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1613,8 +9614,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SourceId); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateFlashcardsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1625,8 +9626,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Source); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateQuizRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1637,8 +9638,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SourceMetadata); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartDraftRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1649,8 +9650,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GoogleDocsSourceMetadata); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1661,8 +9662,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*YoutubeSourceMetadata); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChatHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1673,8 +9674,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SourceSettings); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteChatHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1685,8 +9686,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SourceIssue); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1697,8 +9698,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNotesResponse); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrCreateAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1709,8 +9710,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AudioOverview); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MutateAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1721,8 +9722,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateDocumentGuidesResponse); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitFeedbackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1733,8 +9734,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentGuide); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedbackContext); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1745,8 +9746,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateNotebookGuideResponse); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectAnalyticsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1757,8 +9758,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateOutlineResponse); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectDetailsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1769,8 +9770,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateSectionResponse); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1781,8 +9782,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartDraftResponse); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRecentlyViewedGuidebooksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1793,8 +9794,8 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartSectionResponse); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGuidebookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1805,8 +9806,68 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 				return nil
 			}
 		}
-		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRecentlyViewedProjectsResponse); i {
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGuidebookDetailsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishGuidebookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareGuidebookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteGuidebookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuidebookGenerateAnswerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareAudioRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1818,19 +9879,21 @@ func file_notebooklm_v1alpha1_notebooklm_proto_init() {
 			}
 		}
 	}
-	file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*SourceMetadata_GoogleDocs)(nil),
 		(*SourceMetadata_Youtube)(nil),
 	}
+	file_notebooklm_v1alpha1_notebooklm_proto_msgTypes[80].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_notebooklm_v1alpha1_notebooklm_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   19,
+			NumEnums:      11,
+			NumMessages:   111,
 			NumExtensions: 0,
-			NumServices:   0,
+			NumServices:   3,
 		},
 		GoTypes:           file_notebooklm_v1alpha1_notebooklm_proto_goTypes,
 		DependencyIndexes: file_notebooklm_v1alpha1_notebooklm_proto_depIdxs,
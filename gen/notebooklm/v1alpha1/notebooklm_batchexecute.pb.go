@@ -0,0 +1,1387 @@
+// Code generated by protoc-gen-batchexecute. DO NOT EDIT.
+// source: notebooklm/v1alpha1/notebooklm.proto
+
+package notebooklmv1alpha1
+
+import (
+	context "context"
+	fmt "fmt"
+	beprotojson "github.com/zbigniew-malinowski/nlm/internal/beprotojson"
+	rpc "github.com/zbigniew-malinowski/nlm/internal/rpc"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RPC IDs for the NotebookLM service.
+const (
+	NotebookLM_ListRecentlyViewedProjects_RPCID  = "wXbhsf"
+	NotebookLM_CreateProject_RPCID               = "CCqFvf"
+	NotebookLM_GetProject_RPCID                  = "rLM1Ne"
+	NotebookLM_DeleteProjects_RPCID              = "WWINqb"
+	NotebookLM_MutateProject_RPCID               = "s0tc2d"
+	NotebookLM_RemoveRecentlyViewedProject_RPCID = "fejl7e"
+	NotebookLM_AddSources_RPCID                  = "izAoDd"
+	NotebookLM_AddFileSources_RPCID              = "izAoDd"
+	NotebookLM_DeleteSources_RPCID               = "tGMBJ"
+	NotebookLM_MutateSource_RPCID                = "b7Wfje"
+	NotebookLM_RefreshSource_RPCID               = "FLmJqe"
+	NotebookLM_LoadSource_RPCID                  = "hizoJc"
+	NotebookLM_CheckSourceFreshness_RPCID        = "yR9Yof"
+	NotebookLM_ActOnSources_RPCID                = "yyryJe"
+	NotebookLM_CreateNote_RPCID                  = "CYK0Xb"
+	NotebookLM_MutateNote_RPCID                  = "cYAfTb"
+	NotebookLM_DeleteNotes_RPCID                 = "AH0mwd"
+	NotebookLM_GetNotes_RPCID                    = "cFji9"
+	NotebookLM_CreateAudioOverview_RPCID         = "AHyHrd"
+	NotebookLM_GetAudioOverview_RPCID            = "VUsiyb"
+	NotebookLM_DeleteAudioOverview_RPCID         = "sJDbic"
+	NotebookLM_GenerateDocumentGuides_RPCID      = "tr032e"
+	NotebookLM_GenerateNotebookGuide_RPCID       = "VfAZjd"
+	NotebookLM_GenerateOutline_RPCID             = "lCjAd"
+	NotebookLM_GenerateSection_RPCID             = "BeTrYd"
+	NotebookLM_GenerateArtifact_RPCID            = "ciyUvf"
+	NotebookLM_GenerateMindMap_RPCID             = "Mw3cyf"
+	NotebookLM_GenerateFlashcards_RPCID          = "t8kmZe"
+	NotebookLM_GenerateQuiz_RPCID                = "Sx0Lrd"
+	NotebookLM_StartDraft_RPCID                  = "exXvGf"
+	NotebookLM_StartSection_RPCID                = "pGC7gf"
+	NotebookLM_GetChatHistory_RPCID              = "khqZz"
+	NotebookLM_DeleteChatHistory_RPCID           = "J7Gthc"
+	NotebookLM_GetOrCreateAccount_RPCID          = "ZwVcOc"
+	NotebookLM_MutateAccount_RPCID               = "hT54vc"
+	NotebookLM_GetProjectAnalytics_RPCID         = "AUrzMb"
+	NotebookLM_SubmitFeedback_RPCID              = "uNyJKe"
+)
+
+func init() {
+	rpc.Register(
+		rpc.Info{
+			ID:           NotebookLM_ListRecentlyViewedProjects_RPCID,
+			Name:         "ListRecentlyViewedProjects",
+			Service:      "NotebookLM",
+			ReadOnly:     true,
+			Idempotent:   true,
+			ResponseType: "notebooklm.v1alpha1.ListRecentlyViewedProjectsResponse",
+		},
+		rpc.Info{
+			ID:           NotebookLM_CreateProject_RPCID,
+			Name:         "CreateProject",
+			Service:      "NotebookLM",
+			ResponseType: "notebooklm.v1alpha1.Project",
+		},
+		rpc.Info{
+			ID:             NotebookLM_GetProject_RPCID,
+			Name:           "GetProject",
+			Service:        "NotebookLM",
+			ReadOnly:       true,
+			Idempotent:     true,
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.Project",
+		},
+		rpc.Info{
+			ID:         NotebookLM_DeleteProjects_RPCID,
+			Name:       "DeleteProjects",
+			Service:    "NotebookLM",
+			Idempotent: true,
+		},
+		rpc.Info{
+			ID:             NotebookLM_MutateProject_RPCID,
+			Name:           "MutateProject",
+			Service:        "NotebookLM",
+			Idempotent:     true,
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.Project",
+		},
+		rpc.Info{
+			ID:         NotebookLM_RemoveRecentlyViewedProject_RPCID,
+			Name:       "RemoveRecentlyViewedProject",
+			Service:    "NotebookLM",
+			Idempotent: true,
+		},
+		rpc.Info{
+			ID:             NotebookLM_AddSources_RPCID,
+			Name:           "AddSources",
+			Service:        "NotebookLM",
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.AddSourcesResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_AddFileSources_RPCID,
+			Name:           "AddFileSources",
+			Service:        "NotebookLM",
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.AddSourcesResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_DeleteSources_RPCID,
+			Name:           "DeleteSources",
+			Service:        "NotebookLM",
+			Idempotent:     true,
+			NotebookScoped: true,
+		},
+		rpc.Info{
+			ID:           NotebookLM_MutateSource_RPCID,
+			Name:         "MutateSource",
+			Service:      "NotebookLM",
+			Idempotent:   true,
+			ResponseType: "notebooklm.v1alpha1.Source",
+		},
+		rpc.Info{
+			ID:           NotebookLM_RefreshSource_RPCID,
+			Name:         "RefreshSource",
+			Service:      "NotebookLM",
+			Idempotent:   true,
+			ResponseType: "notebooklm.v1alpha1.Source",
+		},
+		rpc.Info{
+			ID:           NotebookLM_LoadSource_RPCID,
+			Name:         "LoadSource",
+			Service:      "NotebookLM",
+			ReadOnly:     true,
+			Idempotent:   true,
+			ResponseType: "notebooklm.v1alpha1.LoadSourceResponse",
+		},
+		rpc.Info{
+			ID:           NotebookLM_CheckSourceFreshness_RPCID,
+			Name:         "CheckSourceFreshness",
+			Service:      "NotebookLM",
+			ReadOnly:     true,
+			Idempotent:   true,
+			ResponseType: "notebooklm.v1alpha1.CheckSourceFreshnessResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_ActOnSources_RPCID,
+			Name:           "ActOnSources",
+			Service:        "NotebookLM",
+			Idempotent:     true,
+			NotebookScoped: true,
+		},
+		rpc.Info{
+			ID:             NotebookLM_CreateNote_RPCID,
+			Name:           "CreateNote",
+			Service:        "NotebookLM",
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.Source",
+		},
+		rpc.Info{
+			ID:             NotebookLM_MutateNote_RPCID,
+			Name:           "MutateNote",
+			Service:        "NotebookLM",
+			Idempotent:     true,
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.Source",
+		},
+		rpc.Info{
+			ID:             NotebookLM_DeleteNotes_RPCID,
+			Name:           "DeleteNotes",
+			Service:        "NotebookLM",
+			Idempotent:     true,
+			NotebookScoped: true,
+		},
+		rpc.Info{
+			ID:             NotebookLM_GetNotes_RPCID,
+			Name:           "GetNotes",
+			Service:        "NotebookLM",
+			ReadOnly:       true,
+			Idempotent:     true,
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.GetNotesResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_CreateAudioOverview_RPCID,
+			Name:           "CreateAudioOverview",
+			Service:        "NotebookLM",
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.AudioOverviewResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_GetAudioOverview_RPCID,
+			Name:           "GetAudioOverview",
+			Service:        "NotebookLM",
+			ReadOnly:       true,
+			Idempotent:     true,
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.AudioOverviewResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_DeleteAudioOverview_RPCID,
+			Name:           "DeleteAudioOverview",
+			Service:        "NotebookLM",
+			Idempotent:     true,
+			NotebookScoped: true,
+		},
+		rpc.Info{
+			ID:             NotebookLM_GenerateDocumentGuides_RPCID,
+			Name:           "GenerateDocumentGuides",
+			Service:        "NotebookLM",
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.GenerateDocumentGuidesResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_GenerateNotebookGuide_RPCID,
+			Name:           "GenerateNotebookGuide",
+			Service:        "NotebookLM",
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.GenerateNotebookGuideResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_GenerateOutline_RPCID,
+			Name:           "GenerateOutline",
+			Service:        "NotebookLM",
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.GenerateOutlineResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_GenerateSection_RPCID,
+			Name:           "GenerateSection",
+			Service:        "NotebookLM",
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.GenerateSectionResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_GenerateArtifact_RPCID,
+			Name:           "GenerateArtifact",
+			Service:        "NotebookLM",
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.GenerateArtifactResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_GenerateMindMap_RPCID,
+			Name:           "GenerateMindMap",
+			Service:        "NotebookLM",
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.GenerateMindMapResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_GenerateFlashcards_RPCID,
+			Name:           "GenerateFlashcards",
+			Service:        "NotebookLM",
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.GenerateFlashcardsResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_GenerateQuiz_RPCID,
+			Name:           "GenerateQuiz",
+			Service:        "NotebookLM",
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.GenerateQuizResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_StartDraft_RPCID,
+			Name:           "StartDraft",
+			Service:        "NotebookLM",
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.StartDraftResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_StartSection_RPCID,
+			Name:           "StartSection",
+			Service:        "NotebookLM",
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.StartSectionResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_GetChatHistory_RPCID,
+			Name:           "GetChatHistory",
+			Service:        "NotebookLM",
+			ReadOnly:       true,
+			Idempotent:     true,
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.GetChatHistoryResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLM_DeleteChatHistory_RPCID,
+			Name:           "DeleteChatHistory",
+			Service:        "NotebookLM",
+			Idempotent:     true,
+			NotebookScoped: true,
+		},
+		rpc.Info{
+			ID:           NotebookLM_GetOrCreateAccount_RPCID,
+			Name:         "GetOrCreateAccount",
+			Service:      "NotebookLM",
+			Idempotent:   true,
+			ResponseType: "notebooklm.v1alpha1.Account",
+		},
+		rpc.Info{
+			ID:           NotebookLM_MutateAccount_RPCID,
+			Name:         "MutateAccount",
+			Service:      "NotebookLM",
+			Idempotent:   true,
+			ResponseType: "notebooklm.v1alpha1.Account",
+		},
+		rpc.Info{
+			ID:             NotebookLM_GetProjectAnalytics_RPCID,
+			Name:           "GetProjectAnalytics",
+			Service:        "NotebookLM",
+			ReadOnly:       true,
+			Idempotent:     true,
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.ProjectAnalytics",
+		},
+		rpc.Info{
+			ID:             NotebookLM_SubmitFeedback_RPCID,
+			Name:           "SubmitFeedback",
+			Service:        "NotebookLM",
+			NotebookScoped: true,
+		},
+	)
+}
+
+// NotebookLMClient is a typed batchexecute client for the NotebookLM service.
+type NotebookLMClient struct {
+	rpc *rpc.Client
+}
+
+// NewNotebookLMClient returns a NotebookLMClient that sends calls through c.
+func NewNotebookLMClient(c *rpc.Client) *NotebookLMClient {
+	return &NotebookLMClient{rpc: c}
+}
+
+// ListRecentlyViewedProjects calls NotebookLM.ListRecentlyViewedProjects.
+func (c *NotebookLMClient) ListRecentlyViewedProjects(ctx context.Context, in *ListRecentlyViewedProjectsRequest) (*ListRecentlyViewedProjectsResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLM_ListRecentlyViewedProjects_RPCID,
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(ListRecentlyViewedProjectsResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// CreateProject calls NotebookLM.CreateProject.
+func (c *NotebookLMClient) CreateProject(ctx context.Context, in *CreateProjectRequest) (*Project, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLM_CreateProject_RPCID,
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(Project)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// GetProject calls NotebookLM.GetProject.
+func (c *NotebookLMClient) GetProject(ctx context.Context, in *LoadNotebookRequest) (*Project, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_GetProject_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(Project)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// DeleteProjects calls NotebookLM.DeleteProjects.
+func (c *NotebookLMClient) DeleteProjects(ctx context.Context, in *DeleteProjectsRequest) (*emptypb.Empty, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	if _, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLM_DeleteProjects_RPCID,
+		Args: args,
+	}); err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+// MutateProject calls NotebookLM.MutateProject.
+func (c *NotebookLMClient) MutateProject(ctx context.Context, in *MutateProjectRequest) (*Project, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_MutateProject_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(Project)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// RemoveRecentlyViewedProject calls NotebookLM.RemoveRecentlyViewedProject.
+func (c *NotebookLMClient) RemoveRecentlyViewedProject(ctx context.Context, in *RemoveRecentlyViewedProjectRequest) (*emptypb.Empty, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	if _, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLM_RemoveRecentlyViewedProject_RPCID,
+		Args: args,
+	}); err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+// AddSources calls NotebookLM.AddSources.
+func (c *NotebookLMClient) AddSources(ctx context.Context, in *AddSourceRequest) (*AddSourcesResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_AddSources_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(AddSourcesResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// AddFileSources calls NotebookLM.AddFileSources.
+//
+// AddFileSources is AddSources with uploaded file content.
+func (c *NotebookLMClient) AddFileSources(ctx context.Context, in *AddFileSourceRequest) (*AddSourcesResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_AddFileSources_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(AddSourcesResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// DeleteSources calls NotebookLM.DeleteSources.
+func (c *NotebookLMClient) DeleteSources(ctx context.Context, in *DeleteSourcesRequest) (*emptypb.Empty, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	if _, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLM_DeleteSources_RPCID,
+		Args: args,
+	}); err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+// MutateSource calls NotebookLM.MutateSource.
+func (c *NotebookLMClient) MutateSource(ctx context.Context, in *MutateSourceRequest) (*Source, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLM_MutateSource_RPCID,
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(Source)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// RefreshSource calls NotebookLM.RefreshSource.
+func (c *NotebookLMClient) RefreshSource(ctx context.Context, in *RefreshSourceRequest) (*Source, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLM_RefreshSource_RPCID,
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(Source)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// LoadSource calls NotebookLM.LoadSource.
+func (c *NotebookLMClient) LoadSource(ctx context.Context, in *LoadSourceRequest) (*LoadSourceResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLM_LoadSource_RPCID,
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(LoadSourceResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// CheckSourceFreshness calls NotebookLM.CheckSourceFreshness.
+func (c *NotebookLMClient) CheckSourceFreshness(ctx context.Context, in *CheckSourceFreshnessRequest) (*CheckSourceFreshnessResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLM_CheckSourceFreshness_RPCID,
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(CheckSourceFreshnessResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// ActOnSources calls NotebookLM.ActOnSources.
+func (c *NotebookLMClient) ActOnSources(ctx context.Context, in *ActOnSourcesRequest) (*emptypb.Empty, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	if _, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_ActOnSources_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	}); err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+// CreateNote calls NotebookLM.CreateNote.
+func (c *NotebookLMClient) CreateNote(ctx context.Context, in *CreateNoteRequest) (*Source, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_CreateNote_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(Source)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// MutateNote calls NotebookLM.MutateNote.
+func (c *NotebookLMClient) MutateNote(ctx context.Context, in *MutateNoteRequest) (*Source, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_MutateNote_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(Source)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// DeleteNotes calls NotebookLM.DeleteNotes.
+func (c *NotebookLMClient) DeleteNotes(ctx context.Context, in *DeleteNotesRequest) (*emptypb.Empty, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	if _, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLM_DeleteNotes_RPCID,
+		Args: args,
+	}); err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+// GetNotes calls NotebookLM.GetNotes.
+func (c *NotebookLMClient) GetNotes(ctx context.Context, in *GetNotesRequest) (*GetNotesResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_GetNotes_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(GetNotesResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// CreateAudioOverview calls NotebookLM.CreateAudioOverview.
+func (c *NotebookLMClient) CreateAudioOverview(ctx context.Context, in *CreateAudioOverviewRequest) (*AudioOverviewResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_CreateAudioOverview_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(AudioOverviewResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// GetAudioOverview calls NotebookLM.GetAudioOverview.
+func (c *NotebookLMClient) GetAudioOverview(ctx context.Context, in *GetAudioOverviewRequest) (*AudioOverviewResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_GetAudioOverview_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(AudioOverviewResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// DeleteAudioOverview calls NotebookLM.DeleteAudioOverview.
+func (c *NotebookLMClient) DeleteAudioOverview(ctx context.Context, in *DeleteAudioOverviewRequest) (*emptypb.Empty, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	if _, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_DeleteAudioOverview_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	}); err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+// GenerateDocumentGuides calls NotebookLM.GenerateDocumentGuides.
+func (c *NotebookLMClient) GenerateDocumentGuides(ctx context.Context, in *GenerateDocumentGuidesRequest) (*GenerateDocumentGuidesResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_GenerateDocumentGuides_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(GenerateDocumentGuidesResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// GenerateNotebookGuide calls NotebookLM.GenerateNotebookGuide.
+func (c *NotebookLMClient) GenerateNotebookGuide(ctx context.Context, in *GenerateNotebookGuideRequest) (*GenerateNotebookGuideResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_GenerateNotebookGuide_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(GenerateNotebookGuideResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// GenerateOutline calls NotebookLM.GenerateOutline.
+func (c *NotebookLMClient) GenerateOutline(ctx context.Context, in *GenerateOutlineRequest) (*GenerateOutlineResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_GenerateOutline_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(GenerateOutlineResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// GenerateSection calls NotebookLM.GenerateSection.
+func (c *NotebookLMClient) GenerateSection(ctx context.Context, in *GenerateSectionRequest) (*GenerateSectionResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_GenerateSection_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(GenerateSectionResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// GenerateArtifact calls NotebookLM.GenerateArtifact.
+func (c *NotebookLMClient) GenerateArtifact(ctx context.Context, in *GenerateArtifactRequest) (*GenerateArtifactResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_GenerateArtifact_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(GenerateArtifactResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// GenerateMindMap calls NotebookLM.GenerateMindMap.
+func (c *NotebookLMClient) GenerateMindMap(ctx context.Context, in *GenerateMindMapRequest) (*GenerateMindMapResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_GenerateMindMap_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(GenerateMindMapResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// GenerateFlashcards calls NotebookLM.GenerateFlashcards.
+func (c *NotebookLMClient) GenerateFlashcards(ctx context.Context, in *GenerateFlashcardsRequest) (*GenerateFlashcardsResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_GenerateFlashcards_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(GenerateFlashcardsResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// GenerateQuiz calls NotebookLM.GenerateQuiz.
+func (c *NotebookLMClient) GenerateQuiz(ctx context.Context, in *GenerateQuizRequest) (*GenerateQuizResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_GenerateQuiz_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(GenerateQuizResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// StartDraft calls NotebookLM.StartDraft.
+func (c *NotebookLMClient) StartDraft(ctx context.Context, in *StartDraftRequest) (*StartDraftResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_StartDraft_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(StartDraftResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// StartSection calls NotebookLM.StartSection.
+func (c *NotebookLMClient) StartSection(ctx context.Context, in *StartSectionRequest) (*StartSectionResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_StartSection_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(StartSectionResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// GetChatHistory calls NotebookLM.GetChatHistory.
+func (c *NotebookLMClient) GetChatHistory(ctx context.Context, in *GetChatHistoryRequest) (*GetChatHistoryResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_GetChatHistory_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(GetChatHistoryResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// DeleteChatHistory calls NotebookLM.DeleteChatHistory.
+func (c *NotebookLMClient) DeleteChatHistory(ctx context.Context, in *DeleteChatHistoryRequest) (*emptypb.Empty, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	if _, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_DeleteChatHistory_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	}); err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+// GetOrCreateAccount calls NotebookLM.GetOrCreateAccount.
+func (c *NotebookLMClient) GetOrCreateAccount(ctx context.Context, in *GetOrCreateAccountRequest) (*Account, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLM_GetOrCreateAccount_RPCID,
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(Account)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// MutateAccount calls NotebookLM.MutateAccount.
+func (c *NotebookLMClient) MutateAccount(ctx context.Context, in *MutateAccountRequest) (*Account, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLM_MutateAccount_RPCID,
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(Account)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// GetProjectAnalytics calls NotebookLM.GetProjectAnalytics.
+func (c *NotebookLMClient) GetProjectAnalytics(ctx context.Context, in *GetProjectAnalyticsRequest) (*ProjectAnalytics, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_GetProjectAnalytics_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(ProjectAnalytics)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// SubmitFeedback calls NotebookLM.SubmitFeedback.
+func (c *NotebookLMClient) SubmitFeedback(ctx context.Context, in *SubmitFeedbackRequest) (*emptypb.Empty, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	if _, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLM_SubmitFeedback_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	}); err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+// RPC IDs for the NotebookLMSharing service.
+const (
+	NotebookLMSharing_ShareAudio_RPCID        = "RGP97b"
+	NotebookLMSharing_GetProjectDetails_RPCID = "JFMDGd"
+	NotebookLMSharing_ShareProject_RPCID      = "QDyure"
+)
+
+func init() {
+	rpc.Register(
+		rpc.Info{
+			ID:             NotebookLMSharing_ShareAudio_RPCID,
+			Name:           "ShareAudio",
+			Service:        "NotebookLMSharing",
+			Idempotent:     true,
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.ShareAudioResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLMSharing_GetProjectDetails_RPCID,
+			Name:           "GetProjectDetails",
+			Service:        "NotebookLMSharing",
+			ReadOnly:       true,
+			Idempotent:     true,
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.ProjectDetails",
+		},
+		rpc.Info{
+			ID:             NotebookLMSharing_ShareProject_RPCID,
+			Name:           "ShareProject",
+			Service:        "NotebookLMSharing",
+			Idempotent:     true,
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.ShareProjectResponse",
+		},
+	)
+}
+
+// NotebookLMSharingClient is a typed batchexecute client for the NotebookLMSharing service.
+type NotebookLMSharingClient struct {
+	rpc *rpc.Client
+}
+
+// NewNotebookLMSharingClient returns a NotebookLMSharingClient that sends calls through c.
+func NewNotebookLMSharingClient(c *rpc.Client) *NotebookLMSharingClient {
+	return &NotebookLMSharingClient{rpc: c}
+}
+
+// ShareAudio calls NotebookLMSharing.ShareAudio.
+func (c *NotebookLMSharingClient) ShareAudio(ctx context.Context, in *ShareAudioRequest) (*ShareAudioResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLMSharing_ShareAudio_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(ShareAudioResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// GetProjectDetails calls NotebookLMSharing.GetProjectDetails.
+func (c *NotebookLMSharingClient) GetProjectDetails(ctx context.Context, in *GetProjectDetailsRequest) (*ProjectDetails, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLMSharing_GetProjectDetails_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(ProjectDetails)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// ShareProject calls NotebookLMSharing.ShareProject.
+func (c *NotebookLMSharingClient) ShareProject(ctx context.Context, in *ShareProjectRequest) (*ShareProjectResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLMSharing_ShareProject_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(ShareProjectResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// RPC IDs for the NotebookLMGuidebooks service.
+const (
+	NotebookLMGuidebooks_DeleteGuidebook_RPCID              = "ARGkVc"
+	NotebookLMGuidebooks_GetGuidebook_RPCID                 = "EYqtU"
+	NotebookLMGuidebooks_ListRecentlyViewedGuidebooks_RPCID = "YJBpHc"
+	NotebookLMGuidebooks_PublishGuidebook_RPCID             = "R6smae"
+	NotebookLMGuidebooks_GetGuidebookDetails_RPCID          = "LJyzeb"
+	NotebookLMGuidebooks_ShareGuidebook_RPCID               = "OTl0K"
+	NotebookLMGuidebooks_GuidebookGenerateAnswer_RPCID      = "itA0pc"
+)
+
+func init() {
+	rpc.Register(
+		rpc.Info{
+			ID:         NotebookLMGuidebooks_DeleteGuidebook_RPCID,
+			Name:       "DeleteGuidebook",
+			Service:    "NotebookLMGuidebooks",
+			Idempotent: true,
+		},
+		rpc.Info{
+			ID:           NotebookLMGuidebooks_GetGuidebook_RPCID,
+			Name:         "GetGuidebook",
+			Service:      "NotebookLMGuidebooks",
+			ReadOnly:     true,
+			Idempotent:   true,
+			ResponseType: "notebooklm.v1alpha1.Guidebook",
+		},
+		rpc.Info{
+			ID:           NotebookLMGuidebooks_ListRecentlyViewedGuidebooks_RPCID,
+			Name:         "ListRecentlyViewedGuidebooks",
+			Service:      "NotebookLMGuidebooks",
+			ReadOnly:     true,
+			Idempotent:   true,
+			ResponseType: "notebooklm.v1alpha1.ListRecentlyViewedGuidebooksResponse",
+		},
+		rpc.Info{
+			ID:             NotebookLMGuidebooks_PublishGuidebook_RPCID,
+			Name:           "PublishGuidebook",
+			Service:        "NotebookLMGuidebooks",
+			NotebookScoped: true,
+			ResponseType:   "notebooklm.v1alpha1.Guidebook",
+		},
+		rpc.Info{
+			ID:           NotebookLMGuidebooks_GetGuidebookDetails_RPCID,
+			Name:         "GetGuidebookDetails",
+			Service:      "NotebookLMGuidebooks",
+			ReadOnly:     true,
+			Idempotent:   true,
+			ResponseType: "notebooklm.v1alpha1.GuidebookDetails",
+		},
+		rpc.Info{
+			ID:           NotebookLMGuidebooks_ShareGuidebook_RPCID,
+			Name:         "ShareGuidebook",
+			Service:      "NotebookLMGuidebooks",
+			Idempotent:   true,
+			ResponseType: "notebooklm.v1alpha1.ShareGuidebookResponse",
+		},
+		rpc.Info{
+			ID:           NotebookLMGuidebooks_GuidebookGenerateAnswer_RPCID,
+			Name:         "GuidebookGenerateAnswer",
+			Service:      "NotebookLMGuidebooks",
+			ResponseType: "notebooklm.v1alpha1.GuidebookGenerateAnswerResponse",
+		},
+	)
+}
+
+// NotebookLMGuidebooksClient is a typed batchexecute client for the NotebookLMGuidebooks service.
+type NotebookLMGuidebooksClient struct {
+	rpc *rpc.Client
+}
+
+// NewNotebookLMGuidebooksClient returns a NotebookLMGuidebooksClient that sends calls through c.
+func NewNotebookLMGuidebooksClient(c *rpc.Client) *NotebookLMGuidebooksClient {
+	return &NotebookLMGuidebooksClient{rpc: c}
+}
+
+// DeleteGuidebook calls NotebookLMGuidebooks.DeleteGuidebook.
+func (c *NotebookLMGuidebooksClient) DeleteGuidebook(ctx context.Context, in *DeleteGuidebookRequest) (*emptypb.Empty, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	if _, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLMGuidebooks_DeleteGuidebook_RPCID,
+		Args: args,
+	}); err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+// GetGuidebook calls NotebookLMGuidebooks.GetGuidebook.
+func (c *NotebookLMGuidebooksClient) GetGuidebook(ctx context.Context, in *GetGuidebookRequest) (*Guidebook, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLMGuidebooks_GetGuidebook_RPCID,
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(Guidebook)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// ListRecentlyViewedGuidebooks calls NotebookLMGuidebooks.ListRecentlyViewedGuidebooks.
+func (c *NotebookLMGuidebooksClient) ListRecentlyViewedGuidebooks(ctx context.Context, in *ListRecentlyViewedGuidebooksRequest) (*ListRecentlyViewedGuidebooksResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLMGuidebooks_ListRecentlyViewedGuidebooks_RPCID,
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(ListRecentlyViewedGuidebooksResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// PublishGuidebook calls NotebookLMGuidebooks.PublishGuidebook.
+func (c *NotebookLMGuidebooksClient) PublishGuidebook(ctx context.Context, in *PublishGuidebookRequest) (*Guidebook, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:         NotebookLMGuidebooks_PublishGuidebook_RPCID,
+		Args:       args,
+		NotebookID: in.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(Guidebook)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// GetGuidebookDetails calls NotebookLMGuidebooks.GetGuidebookDetails.
+func (c *NotebookLMGuidebooksClient) GetGuidebookDetails(ctx context.Context, in *GetGuidebookDetailsRequest) (*GuidebookDetails, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLMGuidebooks_GetGuidebookDetails_RPCID,
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(GuidebookDetails)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// ShareGuidebook calls NotebookLMGuidebooks.ShareGuidebook.
+func (c *NotebookLMGuidebooksClient) ShareGuidebook(ctx context.Context, in *ShareGuidebookRequest) (*ShareGuidebookResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLMGuidebooks_ShareGuidebook_RPCID,
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(ShareGuidebookResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
+
+// GuidebookGenerateAnswer calls NotebookLMGuidebooks.GuidebookGenerateAnswer.
+func (c *NotebookLMGuidebooksClient) GuidebookGenerateAnswer(ctx context.Context, in *GuidebookGenerateAnswerRequest) (*GuidebookGenerateAnswerResponse, error) {
+	args, err := rpc.ArgsFromProto(in)
+	if err != nil {
+		return nil, fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.DoContext(ctx, rpc.Call{
+		ID:   NotebookLMGuidebooks_GuidebookGenerateAnswer_RPCID,
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := new(GuidebookGenerateAnswerResponse)
+	if err := beprotojson.Unmarshal(resp, out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,149 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/beprotojson"
+	"github.com/zbigniew-malinowski/nlm/internal/rpc"
+)
+
+// AskOptions configures a question asked with Ask. The zero value starts a
+// new conversation.
+type AskOptions struct {
+	// SourceIDs restricts the answer to these sources. By default every
+	// enabled source of the notebook is used.
+	SourceIDs []string
+
+	// Sources are the sources of the notebook, which give the default
+	// selection and the titles of cited sources. If nil, Ask fetches the
+	// notebook; a chat session can fetch it once and reuse its sources.
+	Sources []*pb.Source
+
+	// ConversationID and History continue an earlier conversation. Both
+	// are returned in, or built from, the previous Answer.
+	ConversationID string
+	History        []*pb.ChatTurn
+
+	// OnText, if set, is called with each new piece of the answer as it
+	// is generated.
+	OnText func(text string)
+}
+
+// Answer is the response to a question asked with Ask.
+type Answer struct {
+	Text           string
+	Citations      []Citation
+	ConversationID string
+}
+
+// Citation links part of an answer to the source passage it is based on.
+type Citation struct {
+	SourceID string
+	Title    string // title of the source, if it is still in the notebook
+	Quote    string // quoted span of the source
+}
+
+// Ask asks a question grounded in the sources of a notebook.
+func (c *Client) Ask(notebookID, question string, opts *AskOptions) (*Answer, error) {
+	if opts == nil {
+		opts = &AskOptions{}
+	}
+	sources := opts.Sources
+	if sources == nil {
+		project, err := c.GetProject(notebookID)
+		if err != nil {
+			return nil, fmt.Errorf("ask: %w", err)
+		}
+		sources = project.Sources
+	}
+	titles := make(map[string]string, len(sources))
+	sourceIDs := opts.SourceIDs
+	for _, src := range sources {
+		id := src.SourceId.GetSourceId()
+		titles[id] = strings.TrimSpace(src.Title)
+		disabled := src.GetSettings().GetStatus() == pb.SourceSettings_SOURCE_STATUS_DISABLED
+		if len(opts.SourceIDs) == 0 && !disabled {
+			sourceIDs = append(sourceIDs, id)
+		}
+	}
+	req := &pb.ChatRequest{
+		Sources:        sourceIDLists(sourceIDs),
+		Question:       question,
+		History:        opts.History,
+		ConversationId: opts.ConversationID,
+	}
+	args, err := rpc.ArgsFromProto(req)
+	if err != nil {
+		return nil, fmt.Errorf("ask: encode request: %w", err)
+	}
+
+	var last *pb.ChatAnswer
+	err = c.rpc.Stream(context.Background(), rpc.Call{
+		ID:         rpc.StreamGenerateFreeForm,
+		Args:       args,
+		NotebookID: notebookID,
+	}, func(data json.RawMessage) error {
+		var resp pb.ChatResponse
+		if err := beprotojson.Unmarshal(data, &resp); err != nil {
+			return fmt.Errorf("parse response: %w", err)
+		}
+		if resp.Answer == nil {
+			return nil
+		}
+		if opts.OnText != nil {
+			// Frames repeat the answer so far; only pass on what is new.
+			prev := last.GetText()
+			if text := resp.Answer.Text; strings.HasPrefix(text, prev) && len(text) > len(prev) {
+				opts.OnText(text[len(prev):])
+			}
+		}
+		last = resp.Answer
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ask: %w", err)
+	}
+	if last == nil {
+		return nil, fmt.Errorf("ask: no answer received")
+	}
+
+	answer := &Answer{
+		Text:           last.Text,
+		ConversationID: last.ConversationId,
+	}
+	for _, cit := range last.Citations {
+		id := cit.SourceId.GetSourceId()
+		answer.Citations = append(answer.Citations, Citation{
+			SourceID: id,
+			Title:    titles[id],
+			Quote:    cit.Quote,
+		})
+	}
+	return answer, nil
+}
+
+// GetChatHistory returns the chat messages stored for a notebook, oldest
+// first.
+func (c *Client) GetChatHistory(projectID string) ([]*pb.ChatMessage, error) {
+	resp, err := c.svc.GetChatHistory(context.Background(), &pb.GetChatHistoryRequest{
+		ProjectId: projectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get chat history: %w", err)
+	}
+	return resp.Messages, nil
+}
+
+// DeleteChatHistory clears the chat history stored for a notebook.
+func (c *Client) DeleteChatHistory(projectID string) error {
+	if _, err := c.svc.DeleteChatHistory(context.Background(), &pb.DeleteChatHistoryRequest{
+		ProjectId: projectID,
+	}); err != nil {
+		return fmt.Errorf("delete chat history: %w", err)
+	}
+	return nil
+}
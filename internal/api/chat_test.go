@@ -0,0 +1,126 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAsk(t *testing.T) {
+	var freq string // f.req of the chat request
+	c := newTestClient(t, func(call testCall) string {
+		if call.rpcID != "" {
+			return `["Notebook",[[["src1"],"Doc one"],[["src2"],"Doc two"],[["src3"],"Disabled",null,[null,2]]],"nb1"]`
+		}
+		freq = call.freq
+		return `)]}'
+
+52
+[["wrb.fr",null,"[[\"The answer\",\"conv1\"]]"]]
+120
+[["wrb.fr",null,"[[\"The answer is 42.\",\"conv1\",[[[\"src2\"],\"forty-two\"]],true]]"]]
+25
+[["e",4,null,null,214]]
+`
+	})
+
+	var streamed []string
+	answer, err := c.Ask("nb1", "What is the answer?", &AskOptions{
+		OnText: func(text string) { streamed = append(streamed, text) },
+	})
+	if err != nil {
+		t.Fatalf("Ask() error = %v", err)
+	}
+
+	// src3 is disabled, so it is left out of the question.
+	wantReq := `[null,"[[[[\"src1\"]],[[\"src2\"]]],\"What is the answer?\"]"]`
+	if freq != wantReq {
+		t.Errorf("f.req = %s, want %s", freq, wantReq)
+	}
+	if diff := cmp.Diff([]string{"The answer", " is 42."}, streamed); diff != "" {
+		t.Errorf("streamed text mismatch (-want +got):\n%s", diff)
+	}
+	want := &Answer{
+		Text:           "The answer is 42.",
+		ConversationID: "conv1",
+		Citations:      []Citation{{SourceID: "src2", Title: "Doc two", Quote: "forty-two"}},
+	}
+	if diff := cmp.Diff(want, answer); diff != "" {
+		t.Errorf("Ask() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAskWithSources(t *testing.T) {
+	var freq string
+	c := newTestClient(t, func(call testCall) string {
+		if call.rpcID != "" {
+			t.Errorf("Ask with Sources called %s, want no notebook lookup", call.rpcID)
+		}
+		freq = call.freq
+		return `)]}'
+
+120
+[["wrb.fr",null,"[[\"The answer is 42.\",\"conv1\",[[[\"src2\"],\"forty-two\"]],true]]"]]
+`
+	})
+
+	answer, err := c.Ask("nb1", "Why?", &AskOptions{Sources: []*pb.Source{
+		{SourceId: &pb.SourceId{SourceId: "src2"}, Title: "Doc two"},
+	}})
+	if err != nil {
+		t.Fatalf("Ask() error = %v", err)
+	}
+	if want := `[null,"[[[[\"src2\"]]],\"Why?\"]"]`; freq != want {
+		t.Errorf("f.req = %s, want %s", freq, want)
+	}
+	if got := answer.Citations[0].Title; got != "Doc two" {
+		t.Errorf("citation title = %q, want Doc two", got)
+	}
+}
+
+func TestGetChatHistory(t *testing.T) {
+	var calls []string // f.req of every call
+	c := newTestClient(t, func(call testCall) string {
+		calls = append(calls, call.freq)
+		return `[[["m1",1,"What is X?",null,[1700000000,0],"conv1"],["m2",2,"X is Y.",[[["src1"],"Y is X"]],[1700000060,0],"conv1"]]]`
+	})
+
+	got, err := c.GetChatHistory("nb1")
+	if err != nil {
+		t.Fatalf("GetChatHistory() error = %v", err)
+	}
+	want := []*pb.ChatMessage{
+		{
+			MessageId:      "m1",
+			Role:           pb.ChatRole_CHAT_ROLE_USER,
+			Text:           "What is X?",
+			CreateTime:     &timestamppb.Timestamp{Seconds: 1700000000},
+			ConversationId: "conv1",
+		},
+		{
+			MessageId:      "m2",
+			Role:           pb.ChatRole_CHAT_ROLE_MODEL,
+			Text:           "X is Y.",
+			Citations:      []*pb.Citation{{SourceId: &pb.SourceId{SourceId: "src1"}, Quote: "Y is X"}},
+			CreateTime:     &timestamppb.Timestamp{Seconds: 1700000060},
+			ConversationId: "conv1",
+		},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetChatHistory() mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := c.DeleteChatHistory("nb1"); err != nil {
+		t.Fatalf("DeleteChatHistory() error = %v", err)
+	}
+	wantCalls := []string{
+		`[[["khqZz","[\"nb1\"]",null,"generic"]]]`,
+		`[[["J7Gthc","[\"nb1\"]",null,"generic"]]]`,
+	}
+	if diff := cmp.Diff(wantCalls, calls); diff != "" {
+		t.Errorf("f.req mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -2,6 +2,7 @@
 package api
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 	"github.com/zbigniew-malinowski/nlm/internal/batchexecute"
 	"github.com/zbigniew-malinowski/nlm/internal/beprotojson"
 	"github.com/zbigniew-malinowski/nlm/internal/rpc"
+	"google.golang.org/protobuf/proto"
 )
 
 type Notebook = pb.Project
@@ -23,71 +25,65 @@ type Note = pb.Source
 
 // Client handles NotebookLM API interactions.
 type Client struct {
-	rpc *rpc.Client
+	rpc        *rpc.Client
+	svc        *pb.NotebookLMClient
+	sharing    *pb.NotebookLMSharingClient
+	guidebooks *pb.NotebookLMGuidebooksClient
 }
 
 // New creates a new NotebookLM API client.
 func New(authToken, cookies string, opts ...batchexecute.Option) *Client {
+	rpcClient := rpc.New(authToken, cookies, opts...)
 	return &Client{
-		rpc: rpc.New(authToken, cookies, opts...),
+		rpc:        rpcClient,
+		svc:        pb.NewNotebookLMClient(rpcClient),
+		sharing:    pb.NewNotebookLMSharingClient(rpcClient),
+		guidebooks: pb.NewNotebookLMGuidebooksClient(rpcClient),
 	}
 }
 
+// DoRPC sends an arbitrary RPC call and returns the raw response payload.
+// It is meant for exploring endpoints that have no typed wrapper yet.
+func (c *Client) DoRPC(call rpc.Call) (json.RawMessage, error) {
+	return c.rpc.Do(call)
+}
+
 // Project/Notebook operations
 
 func (c *Client) ListRecentlyViewedProjects() ([]*Notebook, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:   rpc.RPCListRecentlyViewedProjects,
-		Args: []interface{}{nil, 1},
+	resp, err := c.svc.ListRecentlyViewedProjects(context.Background(), &pb.ListRecentlyViewedProjectsRequest{
+		Options: 1,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("list projects: %w", err)
 	}
-
-	var response pb.ListRecentlyViewedProjectsResponse
-	if err := beprotojson.Unmarshal(resp, &response); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
-	}
-	return response.Projects, nil
+	return resp.Projects, nil
 }
 
 func (c *Client) CreateProject(title string, emoji string) (*Notebook, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:   rpc.RPCCreateProject,
-		Args: []interface{}{title, emoji},
+	project, err := c.svc.CreateProject(context.Background(), &pb.CreateProjectRequest{
+		Title: title,
+		Emoji: emoji,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create project: %w", err)
 	}
-
-	var project pb.Project
-	if err := beprotojson.Unmarshal(resp, &project); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
-	}
-	return &project, nil
+	return project, nil
 }
 
 func (c *Client) GetProject(projectID string) (*Notebook, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:         rpc.RPCGetProject,
-		Args:       []interface{}{projectID},
-		NotebookID: projectID,
+	project, err := c.svc.GetProject(context.Background(), &pb.LoadNotebookRequest{
+		ProjectId: projectID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("get project: %w", err)
 	}
-
-	var project pb.Project
-	if err := beprotojson.Unmarshal(resp, &project); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
-	}
-	return &project, nil
+	return project, nil
 }
 
 func (c *Client) DeleteProjects(projectIDs []string) error {
-	_, err := c.rpc.Do(rpc.Call{
-		ID:   rpc.RPCDeleteProjects,
-		Args: []interface{}{projectIDs},
+	_, err := c.svc.DeleteProjects(context.Background(), &pb.DeleteProjectsRequest{
+		ProjectIds: projectIDs,
 	})
 	if err != nil {
 		return fmt.Errorf("delete projects: %w", err)
@@ -95,27 +91,22 @@ func (c *Client) DeleteProjects(projectIDs []string) error {
 	return nil
 }
 
+// MutateProject applies a partial update to a notebook. Only the fields set
+// in updates are changed.
 func (c *Client) MutateProject(projectID string, updates *pb.Project) (*Notebook, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:         rpc.RPCMutateProject,
-		Args:       []interface{}{projectID, updates},
-		NotebookID: projectID,
+	project, err := c.svc.MutateProject(context.Background(), &pb.MutateProjectRequest{
+		ProjectId: projectID,
+		Updates:   updates,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("mutate project: %w", err)
 	}
-
-	var project pb.Project
-	if err := beprotojson.Unmarshal(resp, &project); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
-	}
-	return &project, nil
+	return project, nil
 }
 
 func (c *Client) RemoveRecentlyViewedProject(projectID string) error {
-	_, err := c.rpc.Do(rpc.Call{
-		ID:   rpc.RPCRemoveRecentlyViewed,
-		Args: []interface{}{projectID},
+	_, err := c.svc.RemoveRecentlyViewedProject(context.Background(), &pb.RemoveRecentlyViewedProjectRequest{
+		ProjectId: projectID,
 	})
 	return err
 }
@@ -142,89 +133,77 @@ func (c *Client) AddSources(projectID string, sources []*pb.Source) ([]*pb.Sourc
 */
 
 func (c *Client) DeleteSources(projectID string, sourceIDs []string) error {
-	_, err := c.rpc.Do(rpc.Call{
-		ID: rpc.RPCDeleteSources,
-		Args: []interface{}{
-			[][][]string{{sourceIDs}},
-		},
-		NotebookID: projectID,
+	ctx := rpc.WithNotebookID(context.Background(), projectID)
+	_, err := c.svc.DeleteSources(ctx, &pb.DeleteSourcesRequest{
+		Sources: []*pb.SourceIdList{{Ids: sourceIDs}},
 	})
 	return err
 }
 
+// sourceIDLists wraps source IDs the way requests that select sources
+// expect them. Every method taking optional source IDs passes them through
+// here; with none, the request names no sources and the server uses every
+// enabled source of the notebook.
+func sourceIDLists(sourceIDs []string) []*pb.SourceIdList {
+	var lists []*pb.SourceIdList
+	for _, id := range sourceIDs {
+		lists = append(lists, &pb.SourceIdList{Ids: []string{id}})
+	}
+	return lists
+}
+
 func (c *Client) MutateSource(sourceID string, updates *pb.Source) (*pb.Source, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:   rpc.RPCMutateSource,
-		Args: []interface{}{sourceID, updates},
+	source, err := c.svc.MutateSource(context.Background(), &pb.MutateSourceRequest{
+		SourceId: sourceID,
+		Updates:  updates,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("mutate source: %w", err)
 	}
-
-	var source pb.Source
-	if err := beprotojson.Unmarshal(resp, &source); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
-	}
-	return &source, nil
+	return source, nil
 }
 
 func (c *Client) RefreshSource(sourceID string) (*pb.Source, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:   rpc.RPCRefreshSource,
-		Args: []interface{}{sourceID},
+	source, err := c.svc.RefreshSource(context.Background(), &pb.RefreshSourceRequest{
+		SourceId: sourceID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("refresh source: %w", err)
 	}
-
-	var source pb.Source
-	if err := beprotojson.Unmarshal(resp, &source); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
-	}
-	return &source, nil
+	return source, nil
 }
 
-func (c *Client) LoadSource(sourceID string) (*pb.Source, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:   rpc.RPCLoadSource,
-		Args: []interface{}{sourceID},
+// LoadSource returns a source and the text extracted from it.
+func (c *Client) LoadSource(sourceID string) (*pb.LoadSourceResponse, error) {
+	source, err := c.svc.LoadSource(context.Background(), &pb.LoadSourceRequest{
+		SourceId: sourceID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("load source: %w", err)
 	}
-
-	var source pb.Source
-	if err := beprotojson.Unmarshal(resp, &source); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
-	}
-	return &source, nil
+	return source, nil
 }
 
-/*
 func (c *Client) CheckSourceFreshness(sourceID string) (*pb.CheckSourceFreshnessResponse, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:   rpc.RPCCheckSourceFreshness,
-		Args: []interface{}{sourceID},
+	resp, err := c.svc.CheckSourceFreshness(context.Background(), &pb.CheckSourceFreshnessRequest{
+		SourceId: sourceID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("check source freshness: %w", err)
 	}
-
-	var result pb.CheckSourceFreshnessResponse
-	if err := beprotojson.Unmarshal(resp, &result); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
-	}
-	return &result, nil
+	return resp, nil
 }
-*/
 
-func (c *Client) ActOnSources(projectID string, action string, sourceIDs []string) error {
-	_, err := c.rpc.Do(rpc.Call{
-		ID:         rpc.RPCActOnSources,
-		Args:       []interface{}{projectID, action, sourceIDs},
-		NotebookID: projectID,
+// SetSourceEnabled includes a source in chat and generation, or excludes it
+// while keeping it in the notebook, by updating the status in its settings.
+func (c *Client) SetSourceEnabled(sourceID string, enabled bool) (*pb.Source, error) {
+	status := pb.SourceSettings_SOURCE_STATUS_DISABLED
+	if enabled {
+		status = pb.SourceSettings_SOURCE_STATUS_ENABLED
+	}
+	return c.MutateSource(sourceID, &pb.Source{
+		Settings: &pb.SourceSettings{Status: status},
 	})
-	return err
 }
 
 // Source upload utility methods
@@ -246,62 +225,33 @@ func (c *Client) AddSourceFromReader(projectID string, r io.Reader, filename str
 }
 
 func (c *Client) AddSourceFromText(projectID string, content, title string) (string, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:         rpc.RPCAddSources,
-		NotebookID: projectID,
-		Args: []interface{}{
-			[]interface{}{
-				[]interface{}{
-					nil,
-					[]string{
-						title,
-						content,
-					},
-					nil,
-					2, // text source type
-				},
-			},
-			projectID,
-		},
+	id, err := c.addSources(projectID, &pb.AddSourceRequest{
+		Sources: []*pb.SourceInput{{
+			Text:      &pb.TextSourceInput{Title: title, Content: content},
+			InputType: 2, // text source type
+		}},
+		ProjectId: projectID,
 	})
 	if err != nil {
 		return "", fmt.Errorf("add text source: %w", err)
 	}
-
-	sourceID, err := extractSourceID(resp)
-	if err != nil {
-		return "", fmt.Errorf("extract source ID: %w", err)
-	}
-	return sourceID, nil
+	return id, nil
 }
 
 func (c *Client) AddSourceFromBase64(projectID string, content, filename, contentType string) (string, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:         rpc.RPCAddSources,
-		NotebookID: projectID,
-		Args: []interface{}{
-			[]interface{}{
-				[]interface{}{
-					content,
-					filename,
-					contentType,
-					"base64",
-				},
-			},
-			projectID,
-		},
+	id, err := c.addSources(projectID, &pb.AddFileSourceRequest{
+		Sources: []*pb.FileSourceInput{{
+			Content:     content,
+			Filename:    filename,
+			ContentType: contentType,
+			Encoding:    "base64",
+		}},
+		ProjectId: projectID,
 	})
 	if err != nil {
 		return "", fmt.Errorf("add binary source: %w", err)
 	}
-
-	sourceID, err := extractSourceID(resp)
-	if err != nil {
-		fmt.Fprintln(os.Stderr, resp)
-		spew.Dump(resp)
-		return "", fmt.Errorf("extract source ID: %w", err)
-	}
-	return sourceID, nil
+	return id, nil
 }
 
 func (c *Client) AddSourceFromFile(projectID string, filepath string) (string, error) {
@@ -325,30 +275,14 @@ func (c *Client) AddSourceFromURL(projectID string, url string) (string, error)
 		return c.AddYouTubeSource(projectID, videoID)
 	}
 
-	// Regular URL handling
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:         rpc.RPCAddSources,
-		NotebookID: projectID,
-		Args: []interface{}{
-			[]interface{}{
-				[]interface{}{
-					nil,
-					nil,
-					[]string{url},
-				},
-			},
-			projectID,
-		},
+	id, err := c.addSources(projectID, &pb.AddSourceRequest{
+		Sources:   []*pb.SourceInput{{Web: &pb.WebSourceInput{Url: url}}},
+		ProjectId: projectID,
 	})
 	if err != nil {
 		return "", fmt.Errorf("add source from URL: %w", err)
 	}
-
-	sourceID, err := extractSourceID(resp)
-	if err != nil {
-		return "", fmt.Errorf("extract source ID: %w", err)
-	}
-	return sourceID, nil
+	return id, nil
 }
 
 func (c *Client) AddYouTubeSource(projectID, videoID string) (string, error) {
@@ -393,12 +327,44 @@ func (c *Client) AddYouTubeSource(projectID, videoID string) (string, error) {
 	if len(resp) == 0 {
 		return "", fmt.Errorf("empty response from server (check debug output for request details)")
 	}
+	return addedSourceID(resp)
+}
 
-	sourceID, err := extractSourceID(resp)
+// addSources sends an AddSources request, encoded from its proto message
+// like the generated client does, and returns the ID of the added source.
+// The response is decoded by addedSourceID rather than the generated
+// client, so every way of adding a source accepts the same responses.
+func (c *Client) addSources(projectID string, req proto.Message) (string, error) {
+	args, err := rpc.ArgsFromProto(req)
+	if err != nil {
+		return "", fmt.Errorf("encode args: %w", err)
+	}
+	resp, err := c.rpc.Do(rpc.Call{
+		ID:         rpc.RPCAddSources,
+		Args:       args,
+		NotebookID: projectID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return addedSourceID(resp)
+}
+
+// addedSourceID returns the ID of the source an AddSources call created. It
+// decodes the response as an AddSourcesResponse and falls back to the
+// other response shapes extractSourceID knows.
+func addedSourceID(resp json.RawMessage) (string, error) {
+	var added pb.AddSourcesResponse
+	if err := beprotojson.Unmarshal(resp, &added); err == nil && len(added.Sources) > 0 {
+		if id := added.Sources[0].GetSourceId().GetSourceId(); id != "" {
+			return id, nil
+		}
+	}
+	id, err := extractSourceID(resp)
 	if err != nil {
 		return "", fmt.Errorf("extract source ID: %w", err)
 	}
-	return sourceID, nil
+	return id, nil
 }
 
 // Helper function to extract source ID with better error handling
@@ -468,82 +434,54 @@ func extractSourceID(resp json.RawMessage) (string, error) {
 // Note operations
 
 func (c *Client) CreateNote(projectID string, title string, initialContent string) (*Note, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID: rpc.RPCCreateNote,
-		Args: []interface{}{
-			projectID,
-			initialContent,
-			[]int{1}, // note type
-			nil,
-			title,
-		},
-		NotebookID: projectID,
+	note, err := c.svc.CreateNote(context.Background(), &pb.CreateNoteRequest{
+		ProjectId: projectID,
+		Content:   initialContent,
+		NoteType:  []int32{1},
+		Title:     title,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create note: %w", err)
 	}
-
-	var note Note
-	if err := beprotojson.Unmarshal(resp, &note); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
-	}
-	return &note, nil
+	return note, nil
 }
 
 func (c *Client) MutateNote(projectID string, noteID string, content string, title string) (*Note, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID: rpc.RPCMutateNote,
-		Args: []interface{}{
-			projectID,
-			noteID,
-			[][][]interface{}{{
-				{content, title, []interface{}{}},
-			}},
-		},
-		NotebookID: projectID,
+	note, err := c.svc.MutateNote(context.Background(), &pb.MutateNoteRequest{
+		ProjectId: projectID,
+		NoteId:    noteID,
+		Updates: []*pb.NoteUpdate{{
+			Note: &pb.NoteContent{Content: content, Title: title, Unknown: &pb.EmptyList{}},
+		}},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("mutate note: %w", err)
 	}
-
-	var note Note
-	if err := beprotojson.Unmarshal(resp, &note); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
-	}
-	return &note, nil
+	return note, nil
 }
 
 func (c *Client) DeleteNotes(projectID string, noteIDs []string) error {
-	_, err := c.rpc.Do(rpc.Call{
-		ID: rpc.RPCDeleteNotes,
-		Args: []interface{}{
-			[][][]string{{noteIDs}},
-		},
-		NotebookID: projectID,
+	ctx := rpc.WithNotebookID(context.Background(), projectID)
+	_, err := c.svc.DeleteNotes(ctx, &pb.DeleteNotesRequest{
+		Notes: []*pb.SourceIdList{{Ids: noteIDs}},
 	})
 	return err
 }
 
 func (c *Client) GetNotes(projectID string) ([]*Note, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:         rpc.RPCGetNotes,
-		Args:       []interface{}{projectID},
-		NotebookID: projectID,
+	resp, err := c.svc.GetNotes(context.Background(), &pb.GetNotesRequest{
+		ProjectId: projectID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("get notes: %w", err)
 	}
-
-	var response pb.GetNotesResponse
-	if err := beprotojson.Unmarshal(resp, &response); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
-	}
-	return response.Notes, nil
+	return resp.Notes, nil
 }
 
 // Audio operations
 
-func (c *Client) CreateAudioOverview(projectID string, instructions string) (*AudioOverviewResult, error) {
+// CreateAudioOverview starts an audio overview of the given sources.
+func (c *Client) CreateAudioOverview(projectID string, instructions string, sourceIDs ...string) (*AudioOverviewResult, error) {
 	if projectID == "" {
 		return nil, fmt.Errorf("project ID required")
 	}
@@ -551,129 +489,39 @@ func (c *Client) CreateAudioOverview(projectID string, instructions string) (*Au
 		return nil, fmt.Errorf("instructions required")
 	}
 
-	resp, err := c.rpc.Do(rpc.Call{
-		ID: rpc.RPCCreateAudioOverview,
-		Args: []interface{}{
-			projectID,
-			0,
-			[]string{
-				instructions,
-			},
-		},
-		NotebookID: projectID,
+	resp, err := c.svc.CreateAudioOverview(context.Background(), &pb.CreateAudioOverviewRequest{
+		ProjectId:    projectID,
+		AudioType:    proto.Int32(0),
+		Instructions: []string{instructions},
+		Sources:      sourceIDLists(sourceIDs),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create audio overview: %w", err)
 	}
-
-	var data []interface{}
-	if err := json.Unmarshal(resp, &data); err != nil {
-		return nil, fmt.Errorf("parse response JSON: %w", err)
-	}
-
-	result := &AudioOverviewResult{
-		ProjectID: projectID,
-	}
-
-	// Handle empty or nil response
-	if len(data) == 0 {
-		return result, nil
-	}
-
-	// Parse the wrb.fr response format
-	// Format: [null,null,[3,"<base64-audio>","<id>","<title>",null,true],null,[false]]
-	if len(data) > 2 {
-		audioData, ok := data[2].([]interface{})
-		if !ok || len(audioData) < 4 {
-			// Creation might be in progress, return result without error
-			return result, nil
-		}
-
-		// Extract audio data (index 1)
-		if audioBase64, ok := audioData[1].(string); ok {
-			result.AudioData = audioBase64
-		}
-
-		// Extract ID (index 2)
-		if id, ok := audioData[2].(string); ok {
-			result.AudioID = id
-		}
-
-		// Extract title (index 3)
-		if title, ok := audioData[3].(string); ok {
-			result.Title = title
-		}
-
-		// Extract ready status (index 5)
-		if len(audioData) > 5 {
-			if ready, ok := audioData[5].(bool); ok {
-				result.IsReady = ready
-			}
-		}
-	}
-
-	return result, nil
+	// The overview may still be in progress, in which case only the
+	// project ID is set.
+	return newAudioOverviewResult(projectID, resp.AudioOverview), nil
 }
 
 func (c *Client) GetAudioOverview(projectID string) (*AudioOverviewResult, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID: rpc.RPCGetAudioOverview,
-		Args: []interface{}{
-			projectID,
-			1,
-		},
-		NotebookID: projectID,
+	resp, err := c.svc.GetAudioOverview(context.Background(), &pb.GetAudioOverviewRequest{
+		ProjectId:   projectID,
+		RequestType: 1,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("get audio overview: %w", err)
 	}
+	return newAudioOverviewResult(projectID, resp.AudioOverview), nil
+}
 
-	var data []interface{}
-	if err := json.Unmarshal(resp, &data); err != nil {
-		return nil, fmt.Errorf("parse response JSON: %w", err)
-	}
-
-	result := &AudioOverviewResult{
+func newAudioOverviewResult(projectID string, audio *pb.AudioOverview) *AudioOverviewResult {
+	return &AudioOverviewResult{
 		ProjectID: projectID,
+		AudioID:   audio.GetAudioId(),
+		Title:     audio.GetTitle(),
+		AudioData: audio.GetContent(),
+		IsReady:   audio.GetIsReady(),
 	}
-
-	// Handle empty or nil response
-	if len(data) == 0 {
-		return result, nil
-	}
-
-	// Parse the wrb.fr response format
-	// Format: [null,null,[3,"<base64-audio>","<id>","<title>",null,true],null,[false]]
-	if len(data) > 2 {
-		audioData, ok := data[2].([]interface{})
-		if !ok || len(audioData) < 4 {
-			return nil, fmt.Errorf("invalid audio data format")
-		}
-
-		// Extract audio data (index 1)
-		if audioBase64, ok := audioData[1].(string); ok {
-			result.AudioData = audioBase64
-		}
-
-		// Extract ID (index 2)
-		if id, ok := audioData[2].(string); ok {
-			result.AudioID = id
-		}
-
-		// Extract title (index 3)
-		if title, ok := audioData[3].(string); ok {
-			result.Title = title
-		}
-
-		// Extract ready status (index 5)
-		if len(audioData) > 5 {
-			if ready, ok := audioData[5].(bool); ok {
-				result.IsReady = ready
-			}
-		}
-	}
-
-	return result, nil
 }
 
 // AudioOverviewResult represents an audio overview response
@@ -694,10 +542,8 @@ func (r *AudioOverviewResult) GetAudioBytes() ([]byte, error) {
 }
 
 func (c *Client) DeleteAudioOverview(projectID string) error {
-	_, err := c.rpc.Do(rpc.Call{
-		ID:         rpc.RPCDeleteAudioOverview,
-		Args:       []interface{}{projectID},
-		NotebookID: projectID,
+	_, err := c.svc.DeleteAudioOverview(context.Background(), &pb.DeleteAudioOverviewRequest{
+		ProjectId: projectID,
 	})
 	return err
 }
@@ -705,105 +551,191 @@ func (c *Client) DeleteAudioOverview(projectID string) error {
 // Generation operations
 
 func (c *Client) GenerateDocumentGuides(projectID string) (*pb.GenerateDocumentGuidesResponse, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:         rpc.RPCGenerateDocumentGuides,
-		Args:       []interface{}{projectID},
-		NotebookID: projectID,
+	guides, err := c.svc.GenerateDocumentGuides(context.Background(), &pb.GenerateDocumentGuidesRequest{
+		ProjectId: projectID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("generate document guides: %w", err)
 	}
+	return guides, nil
+}
 
-	var guides pb.GenerateDocumentGuidesResponse
-	if err := beprotojson.Unmarshal(resp, &guides); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
+// GenerateNotebookGuide generates a guide to the given sources.
+func (c *Client) GenerateNotebookGuide(projectID string, sourceIDs ...string) (*pb.GenerateNotebookGuideResponse, error) {
+	guide, err := c.svc.GenerateNotebookGuide(context.Background(), &pb.GenerateNotebookGuideRequest{
+		ProjectId: projectID,
+		Sources:   sourceIDLists(sourceIDs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate notebook guide: %w", err)
 	}
-	return &guides, nil
+	return guide, nil
 }
 
-func (c *Client) GenerateNotebookGuide(projectID string) (*pb.GenerateNotebookGuideResponse, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:         rpc.RPCGenerateNotebookGuide,
-		Args:       []interface{}{projectID},
-		NotebookID: projectID,
+// GenerateOutline generates an outline of the given sources.
+func (c *Client) GenerateOutline(projectID string, sourceIDs ...string) (*pb.GenerateOutlineResponse, error) {
+	outline, err := c.svc.GenerateOutline(context.Background(), &pb.GenerateOutlineRequest{
+		ProjectId: projectID,
+		Sources:   sourceIDLists(sourceIDs),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("generate notebook guide: %w", err)
+		return nil, fmt.Errorf("generate outline: %w", err)
 	}
+	return outline, nil
+}
 
-	var guide pb.GenerateNotebookGuideResponse
-	if err := beprotojson.Unmarshal(resp, &guide); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
+// GenerateSection generates a section from the given sources.
+func (c *Client) GenerateSection(projectID string, sourceIDs ...string) (*pb.GenerateSectionResponse, error) {
+	section, err := c.svc.GenerateSection(context.Background(), &pb.GenerateSectionRequest{
+		ProjectId: projectID,
+		Sources:   sourceIDLists(sourceIDs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate section: %w", err)
 	}
-	return &guide, nil
+	return section, nil
 }
 
-func (c *Client) GenerateOutline(projectID string) (*pb.GenerateOutlineResponse, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:         rpc.RPCGenerateOutline,
-		Args:       []interface{}{projectID},
-		NotebookID: projectID,
+// GenerateFAQ generates frequently asked questions about the given sources.
+func (c *Client) GenerateFAQ(projectID string, sourceIDs ...string) (*pb.GenerateArtifactResponse, error) {
+	return c.generateArtifact(projectID, pb.ArtifactType_ARTIFACT_TYPE_FAQ, sourceIDs)
+}
+
+// GenerateStudyGuide generates a study guide with a quiz, essay questions
+// and a glossary.
+func (c *Client) GenerateStudyGuide(projectID string, sourceIDs ...string) (*pb.GenerateArtifactResponse, error) {
+	return c.generateArtifact(projectID, pb.ArtifactType_ARTIFACT_TYPE_STUDY_GUIDE, sourceIDs)
+}
+
+// GenerateBriefingDoc generates a briefing document of the main themes and
+// key quotes.
+func (c *Client) GenerateBriefingDoc(projectID string, sourceIDs ...string) (*pb.GenerateArtifactResponse, error) {
+	return c.generateArtifact(projectID, pb.ArtifactType_ARTIFACT_TYPE_BRIEFING_DOC, sourceIDs)
+}
+
+// GenerateTimeline generates a timeline of the events in the sources and a
+// cast of the people involved.
+func (c *Client) GenerateTimeline(projectID string, sourceIDs ...string) (*pb.GenerateArtifactResponse, error) {
+	return c.generateArtifact(projectID, pb.ArtifactType_ARTIFACT_TYPE_TIMELINE, sourceIDs)
+}
+
+// GenerateMindMap returns the root topic of a mind map of the given sources.
+func (c *Client) GenerateMindMap(projectID string, sourceIDs ...string) (*pb.MindMapNode, error) {
+	resp, err := c.svc.GenerateMindMap(context.Background(), &pb.GenerateMindMapRequest{
+		ProjectId: projectID,
+		Sources:   sourceIDLists(sourceIDs),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("generate outline: %w", err)
+		return nil, fmt.Errorf("generate mind map: %w", err)
 	}
+	if resp.Root == nil {
+		return nil, fmt.Errorf("generate mind map: empty response")
+	}
+	return resp.Root, nil
+}
 
-	var outline pb.GenerateOutlineResponse
-	if err := beprotojson.Unmarshal(resp, &outline); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
+// GenerateFlashcards generates flashcards from the given sources.
+func (c *Client) GenerateFlashcards(projectID string, sourceIDs ...string) ([]*pb.Flashcard, error) {
+	resp, err := c.svc.GenerateFlashcards(context.Background(), &pb.GenerateFlashcardsRequest{
+		ProjectId: projectID,
+		Sources:   sourceIDLists(sourceIDs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate flashcards: %w", err)
 	}
-	return &outline, nil
+	return resp.Flashcards, nil
 }
 
-func (c *Client) GenerateSection(projectID string) (*pb.GenerateSectionResponse, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:         rpc.RPCGenerateSection,
-		Args:       []interface{}{projectID},
-		NotebookID: projectID,
+// GenerateQuiz generates multiple-choice questions about the given sources.
+func (c *Client) GenerateQuiz(projectID string, sourceIDs ...string) ([]*pb.QuizQuestion, error) {
+	resp, err := c.svc.GenerateQuiz(context.Background(), &pb.GenerateQuizRequest{
+		ProjectId: projectID,
+		Sources:   sourceIDLists(sourceIDs),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("generate section: %w", err)
+		return nil, fmt.Errorf("generate quiz: %w", err)
 	}
+	return resp.Questions, nil
+}
 
-	var section pb.GenerateSectionResponse
-	if err := beprotojson.Unmarshal(resp, &section); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
+func (c *Client) generateArtifact(projectID string, typ pb.ArtifactType, sourceIDs []string) (*pb.GenerateArtifactResponse, error) {
+	artifact, err := c.svc.GenerateArtifact(context.Background(), &pb.GenerateArtifactRequest{
+		ProjectId: projectID,
+		Type:      typ,
+		Sources:   sourceIDLists(sourceIDs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate artifact: %w", err)
 	}
-	return &section, nil
+	return artifact, nil
 }
 
 func (c *Client) StartDraft(projectID string) (*pb.StartDraftResponse, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:         rpc.RPCStartDraft,
-		Args:       []interface{}{projectID},
-		NotebookID: projectID,
+	draft, err := c.svc.StartDraft(context.Background(), &pb.StartDraftRequest{
+		ProjectId: projectID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("start draft: %w", err)
 	}
-
-	var draft pb.StartDraftResponse
-	if err := beprotojson.Unmarshal(resp, &draft); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
-	}
-	return &draft, nil
+	return draft, nil
 }
 
 func (c *Client) StartSection(projectID string) (*pb.StartSectionResponse, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID:         rpc.RPCStartSection,
-		Args:       []interface{}{projectID},
-		NotebookID: projectID,
+	section, err := c.svc.StartSection(context.Background(), &pb.StartSectionRequest{
+		ProjectId: projectID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("start section: %w", err)
 	}
+	return section, nil
+}
 
-	var section pb.StartSectionResponse
-	if err := beprotojson.Unmarshal(resp, &section); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
+// Account operations
+
+func (c *Client) GetOrCreateAccount() (*pb.Account, error) {
+	account, err := c.svc.GetOrCreateAccount(context.Background(), &pb.GetOrCreateAccountRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("get account: %w", err)
+	}
+	return account, nil
+}
+
+// MutateAccount changes the account settings that are set in settings and
+// returns the updated account.
+func (c *Client) MutateAccount(settings *pb.AccountSettings) (*pb.Account, error) {
+	account, err := c.svc.MutateAccount(context.Background(), &pb.MutateAccountRequest{
+		Settings: settings,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("mutate account: %w", err)
+	}
+	return account, nil
+}
+
+// Analytics operations
+
+func (c *Client) GetProjectAnalytics(projectID string) (*pb.ProjectAnalytics, error) {
+	analytics, err := c.svc.GetProjectAnalytics(context.Background(), &pb.GetProjectAnalyticsRequest{
+		ProjectId: projectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get project analytics: %w", err)
+	}
+	return analytics, nil
+}
+
+// SubmitFeedback sends feedback to the NotebookLM team. projectID and
+// diagnostics are optional; diagnostics carries details such as the client
+// version.
+func (c *Client) SubmitFeedback(projectID, message string, diagnostics *pb.FeedbackContext) error {
+	if _, err := c.svc.SubmitFeedback(context.Background(), &pb.SubmitFeedbackRequest{
+		ProjectId:    projectID,
+		FeedbackText: message,
+		Context:      diagnostics,
+	}); err != nil {
+		return fmt.Errorf("submit feedback: %w", err)
 	}
-	return &section, nil
+	return nil
 }
 
 // Sharing operations
@@ -825,43 +757,46 @@ type ShareAudioResult struct {
 
 // ShareAudio shares an audio overview with optional public access
 func (c *Client) ShareAudio(projectID string, shareOption ShareOption) (*ShareAudioResult, error) {
-	resp, err := c.rpc.Do(rpc.Call{
-		ID: rpc.RPCShareAudio,
-		Args: []interface{}{
-			[]int{int(shareOption)},
-			projectID,
-		},
-		NotebookID: projectID,
+	resp, err := c.sharing.ShareAudio(context.Background(), &pb.ShareAudioRequest{
+		ShareOptions: []int32{int32(shareOption)},
+		ProjectId:    projectID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("share audio: %w", err)
 	}
+	return &ShareAudioResult{
+		ShareURL: resp.GetShareInfo().GetShareUrl(),
+		ShareID:  resp.GetShareInfo().GetShareId(),
+		IsPublic: shareOption == SharePublic,
+	}, nil
+}
 
-	// Parse the raw response
-	var data []interface{}
-	if err := json.Unmarshal(resp, &data); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
-	}
+// ShareProject sets who can open a notebook through its share link and
+// returns the resulting link.
+func (c *Client) ShareProject(projectID string, access pb.ProjectAccess) (*pb.ShareProjectResponse, error) {
+	return c.shareProject(&pb.ShareProjectRequest{
+		ProjectId: projectID,
+		Access:    access,
+	})
+}
 
-	result := &ShareAudioResult{
-		IsPublic: shareOption == SharePublic,
+func (c *Client) shareProject(req *pb.ShareProjectRequest) (*pb.ShareProjectResponse, error) {
+	resp, err := c.sharing.ShareProject(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("share project: %w", err)
 	}
+	return resp, nil
+}
 
-	// Extract share URL and ID from response
-	if len(data) > 0 {
-		if shareData, ok := data[0].([]interface{}); ok && len(shareData) > 0 {
-			if shareURL, ok := shareData[0].(string); ok {
-				result.ShareURL = shareURL
-			}
-			if len(shareData) > 1 {
-				if shareID, ok := shareData[1].(string); ok {
-					result.ShareID = shareID
-				}
-			}
-		}
+// GetProjectDetails returns the sharing details of a notebook.
+func (c *Client) GetProjectDetails(projectID string) (*pb.ProjectDetails, error) {
+	details, err := c.sharing.GetProjectDetails(context.Background(), &pb.GetProjectDetailsRequest{
+		ProjectId: projectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get project details: %w", err)
 	}
-
-	return result, nil
+	return details, nil
 }
 
 // Helper functions to identify and extract YouTube video IDs
@@ -0,0 +1,279 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/batchexecute"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+// testCall is a request received by a client from newTestClient.
+type testCall struct {
+	rpcID      string // empty for streamed chat requests
+	freq       string
+	sourcePath string
+}
+
+// expiredSession makes newTestClient fail a call with HTTP 401.
+const expiredSession = "<expired session>"
+
+// newTestClient returns a client whose requests are answered by respond. The
+// returned payload is wrapped in a batchexecute response, unless it already
+// starts with the ")]}'" prefix of a full response body.
+func newTestClient(t *testing.T, respond func(call testCall) string) *Client {
+	t.Helper()
+	transport := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("parse form: %v", err)
+		}
+		call := testCall{
+			rpcID:      r.URL.Query().Get("rpcids"),
+			freq:       r.PostForm.Get("f.req"),
+			sourcePath: r.URL.Query().Get("source-path"),
+		}
+		status, body := http.StatusOK, respond(call)
+		switch {
+		case body == expiredSession:
+			status, body = http.StatusUnauthorized, ""
+		case !strings.HasPrefix(body, ")]}'"):
+			payload, err := json.Marshal(body)
+			if err != nil {
+				t.Fatalf("encode response: %v", err)
+			}
+			body = fmt.Sprintf(")]}'\n\n[[\"wrb.fr\",%q,%s,null,null,null,\"generic\"]]", call.rpcID, payload)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Status:     http.StatusText(status),
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    r,
+		}, nil
+	})
+	return New("token", "cookies", batchexecute.WithHTTPClient(&http.Client{Transport: transport}))
+}
+
+func TestGenerateOutlineSources(t *testing.T) {
+	var freq string
+	c := newTestClient(t, func(call testCall) string {
+		freq = call.freq
+		return `[]`
+	})
+
+	if _, err := c.GenerateOutline("nb1", "a", "b"); err != nil {
+		t.Fatalf("GenerateOutline() error = %v", err)
+	}
+	want := `[[["lCjAd","[\"nb1\",[[[\"a\"]],[[\"b\"]]]]",null,"generic"]]]`
+	if freq != want {
+		t.Errorf("f.req = %s, want %s", freq, want)
+	}
+
+	if _, err := c.GenerateOutline("nb1"); err != nil {
+		t.Fatalf("GenerateOutline() error = %v", err)
+	}
+	want = `[[["lCjAd","[\"nb1\"]",null,"generic"]]]`
+	if freq != want {
+		t.Errorf("f.req without sources = %s, want %s", freq, want)
+	}
+}
+
+func TestCreateNote(t *testing.T) {
+	var freq string
+	c := newTestClient(t, func(call testCall) string {
+		freq = call.freq
+		return `[["note1"],"Findings"]`
+	})
+
+	note, err := c.CreateNote("nb1", "Findings", "The answer is 42.")
+	if err != nil {
+		t.Fatalf("CreateNote() error = %v", err)
+	}
+	want := `[[["CYK0Xb","[\"nb1\",\"The answer is 42.\",[1],null,\"Findings\"]",null,"generic"]]]`
+	if freq != want {
+		t.Errorf("f.req = %s, want %s", freq, want)
+	}
+	if got := note.GetSourceId().GetSourceId(); got != "note1" {
+		t.Errorf("note ID = %q, want note1", got)
+	}
+}
+
+// TestRequestEncoding checks that requests sent through the generated client
+// encode exactly as the hand-built arguments they replaced.
+func TestRequestEncoding(t *testing.T) {
+	type call struct{ freq, sourcePath string }
+	var got call
+	c := newTestClient(t, func(tc testCall) string {
+		got = call{tc.freq, tc.sourcePath}
+		return `[]`
+	})
+
+	tests := []struct {
+		name string
+		call func() error
+		want call
+	}{
+		{
+			name: "MutateProject",
+			call: func() error {
+				_, err := c.MutateProject("nb1", &pb.Project{
+					Title:            "Renamed",
+					Config:           &pb.ChatbotConfig{Goal: pb.ConversationalGoal_CONVERSATIONAL_GOAL_LEARNING_GUIDE},
+					AdvancedSettings: &pb.AdvancedSettings{OutputLanguage: wrapperspb.String("de")},
+				})
+				return err
+			},
+			want: call{`[[["s0tc2d","[\"nb1\",[\"Renamed\",null,null,null,null,null,[2],[\"de\"]]]",null,"generic"]]]`, "/notebook/nb1"},
+		},
+		{
+			name: "DeleteSources",
+			call: func() error { return c.DeleteSources("nb1", []string{"s1", "s2"}) },
+			want: call{`[[["tGMBJ","[[[[\"s1\",\"s2\"]]]]",null,"generic"]]]`, "/notebook/nb1"},
+		},
+		{
+			name: "AddSourceFromText",
+			call: func() error { _, err := c.AddSourceFromText("nb1", "hello", "Greeting"); return err },
+			want: call{`[[["izAoDd","[[[null,[\"Greeting\",\"hello\"],null,2]],\"nb1\"]",null,"generic"]]]`, "/notebook/nb1"},
+		},
+		{
+			name: "AddSourceFromBase64",
+			call: func() error { _, err := c.AddSourceFromBase64("nb1", "AAEC", "f.pdf", "application/pdf"); return err },
+			want: call{`[[["izAoDd","[[[\"AAEC\",\"f.pdf\",\"application/pdf\",\"base64\"]],\"nb1\"]",null,"generic"]]]`, "/notebook/nb1"},
+		},
+		{
+			name: "AddSourceFromURL",
+			call: func() error { _, err := c.AddSourceFromURL("nb1", "https://example.com"); return err },
+			want: call{`[[["izAoDd","[[[null,null,[\"https://example.com\"]]],\"nb1\"]",null,"generic"]]]`, "/notebook/nb1"},
+		},
+		{
+			// Unlike the others, this used to send the update through
+			// encoding/json rather than by field position.
+			name: "MutateSource",
+			call: func() error { _, err := c.MutateSource("s1", &pb.Source{Title: "New title"}); return err },
+			want: call{`[[["b7Wfje","[\"s1\",[null,\"New title\"]]",null,"generic"]]]`, "/"},
+		},
+		{
+			name: "SetSourceEnabled",
+			call: func() error { _, err := c.SetSourceEnabled("s1", true); return err },
+			want: call{`[[["b7Wfje","[\"s1\",[null,null,null,[null,1]]]",null,"generic"]]]`, "/"},
+		},
+		{
+			name: "SetSourceEnabled disable",
+			call: func() error { _, err := c.SetSourceEnabled("s1", false); return err },
+			want: call{`[[["b7Wfje","[\"s1\",[null,null,null,[null,2]]]",null,"generic"]]]`, "/"},
+		},
+		{
+			name: "MutateNote",
+			call: func() error { _, err := c.MutateNote("nb1", "n1", "body", "Title"); return err },
+			want: call{`[[["cYAfTb","[\"nb1\",\"n1\",[[[\"body\",\"Title\",[]]]]]",null,"generic"]]]`, "/notebook/nb1"},
+		},
+		{
+			name: "DeleteNotes",
+			call: func() error { return c.DeleteNotes("nb1", []string{"n1", "n2"}) },
+			want: call{`[[["AH0mwd","[[[[\"n1\",\"n2\"]]]]",null,"generic"]]]`, "/notebook/nb1"},
+		},
+		{
+			name: "CreateAudioOverview",
+			call: func() error { _, err := c.CreateAudioOverview("nb1", "Be brief"); return err },
+			want: call{`[[["AHyHrd","[\"nb1\",0,[\"Be brief\"]]",null,"generic"]]]`, "/notebook/nb1"},
+		},
+		{
+			name: "CreateAudioOverview with sources",
+			call: func() error { _, err := c.CreateAudioOverview("nb1", "Be brief", "s1", "s2"); return err },
+			want: call{`[[["AHyHrd","[\"nb1\",0,[\"Be brief\"],[[[\"s1\"]],[[\"s2\"]]]]",null,"generic"]]]`, "/notebook/nb1"},
+		},
+		{
+			name: "GetAudioOverview",
+			call: func() error { _, err := c.GetAudioOverview("nb1"); return err },
+			want: call{`[[["VUsiyb","[\"nb1\",1]",null,"generic"]]]`, "/notebook/nb1"},
+		},
+		{
+			name: "ShareAudio public",
+			call: func() error { _, err := c.ShareAudio("nb1", SharePublic); return err },
+			want: call{`[[["RGP97b","[[1],\"nb1\"]",null,"generic"]]]`, "/notebook/nb1"},
+		},
+		{
+			name: "ShareAudio private",
+			call: func() error { _, err := c.ShareAudio("nb1", SharePrivate); return err },
+			want: call{`[[["RGP97b","[[0],\"nb1\"]",null,"generic"]]]`, "/notebook/nb1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = call{}
+			tt.call() // the empty responses are not what is tested here
+			if got != tt.want {
+				t.Errorf("sent %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetAudioOverview(t *testing.T) {
+	c := newTestClient(t, func(call testCall) string {
+		return `[null,null,[3,"AAEC","audio1","Deep dive",null,true],null,[false]]`
+	})
+	got, err := c.GetAudioOverview("nb1")
+	if err != nil {
+		t.Fatalf("GetAudioOverview() error = %v", err)
+	}
+	want := &AudioOverviewResult{ProjectID: "nb1", AudioID: "audio1", Title: "Deep dive", AudioData: "AAEC", IsReady: true}
+	if *got != *want {
+		t.Errorf("GetAudioOverview() = %+v, want %+v", got, want)
+	}
+}
+
+func TestAddedSourceID(t *testing.T) {
+	// A source as it appears in a captured GetProject response, see
+	// beprotojson_test; AddSources returns the added sources in this shape.
+	const source = `[[["39ed97de-7b93-4e08-8d9b-b86d5a58b35a"]],` +
+		`"Building with Anthropic Claude: Prompt Workshop with Zack Witten",` +
+		`[null,15108,[1728034802,578385000],["0319adc7-1458-4555-a813-17aff0f72938",[1728034801,818692000]],9,` +
+		`["https://www.youtube.com/watch?v=hkhDdcM5V94","hkhDdcM5V94","AI Engineer"]],` +
+		`[null,2]]`
+
+	tests := []struct {
+		name    string
+		resp    string
+		want    string
+		wantErr bool
+	}{
+		{name: "AddSourcesResponse", resp: `[[` + source + `]]`, want: "39ed97de-7b93-4e08-8d9b-b86d5a58b35a"},
+		{name: "nested ID", resp: `[[[["src1",null]]]]`, want: "src1"},
+		{name: "ID in entry", resp: `[[["src1","Title"]]]`, want: "src1"},
+		{name: "bare entry", resp: `[["src1","Title"]]`, want: "src1"},
+		{name: "no sources", resp: `[]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := addedSourceID(json.RawMessage(tt.resp))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("addedSourceID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("addedSourceID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// Every way of adding a source decodes the response the same way.
+	c := newTestClient(t, func(call testCall) string { return `[[` + source + `]]` })
+	for name, add := range map[string]func() (string, error){
+		"text":    func() (string, error) { return c.AddSourceFromText("nb1", "hello", "Greeting") },
+		"base64":  func() (string, error) { return c.AddSourceFromBase64("nb1", "AAEC", "f.pdf", "application/pdf") },
+		"URL":     func() (string, error) { return c.AddSourceFromURL("nb1", "https://example.com") },
+		"YouTube": func() (string, error) { return c.AddYouTubeSource("nb1", "hkhDdcM5V94") },
+	} {
+		if got, err := add(); err != nil || got != "39ed97de-7b93-4e08-8d9b-b86d5a58b35a" {
+			t.Errorf("add %s source = %q, %v", name, got, err)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+// ListCollaborators returns the people a notebook is shared with, including
+// its owner.
+func (c *Client) ListCollaborators(projectID string) ([]*pb.Collaborator, error) {
+	details, err := c.GetProjectDetails(projectID)
+	if err != nil {
+		return nil, err
+	}
+	return details.Collaborators, nil
+}
+
+// AddCollaborator shares a notebook with email as a viewer or editor. If
+// email is already a collaborator, its role is changed as with
+// SetCollaboratorRole.
+func (c *Client) AddCollaborator(projectID, email string, role pb.ProjectRole) error {
+	if err := checkShareRole(role); err != nil {
+		return err
+	}
+	collab, err := c.lookupCollaborator(projectID, email)
+	if err != nil {
+		return err
+	}
+	if collab != nil {
+		email = collab.Email
+	}
+	return c.setCollaborator(projectID, email, role)
+}
+
+// SetCollaboratorRole changes the role of an existing collaborator.
+func (c *Client) SetCollaboratorRole(projectID, email string, role pb.ProjectRole) error {
+	if err := checkShareRole(role); err != nil {
+		return err
+	}
+	collab, err := c.findCollaborator(projectID, email)
+	if err != nil {
+		return err
+	}
+	return c.setCollaborator(projectID, collab.Email, role)
+}
+
+// RemoveCollaborator revokes the access of email to a notebook.
+func (c *Client) RemoveCollaborator(projectID, email string) error {
+	collab, err := c.findCollaborator(projectID, email)
+	if err != nil {
+		return err
+	}
+	return c.setCollaborator(projectID, collab.Email, pb.ProjectRole_PROJECT_ROLE_REMOVED)
+}
+
+// findCollaborator returns the collaborator with the given email. Unlike
+// lookupCollaborator, it is an error if there is none.
+func (c *Client) findCollaborator(projectID, email string) (*pb.Collaborator, error) {
+	collab, err := c.lookupCollaborator(projectID, email)
+	if err != nil {
+		return nil, err
+	}
+	if collab == nil {
+		return nil, fmt.Errorf("%s is not a collaborator", email)
+	}
+	return collab, nil
+}
+
+// lookupCollaborator returns the collaborator with the given email, matched
+// case-insensitively, or nil if there is none. The owner cannot be changed,
+// so finding it is an error.
+func (c *Client) lookupCollaborator(projectID, email string) (*pb.Collaborator, error) {
+	collaborators, err := c.ListCollaborators(projectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, collab := range collaborators {
+		if !strings.EqualFold(collab.Email, email) {
+			continue
+		}
+		if collab.Role == pb.ProjectRole_PROJECT_ROLE_OWNER {
+			return nil, fmt.Errorf("%s is the owner of the notebook", collab.Email)
+		}
+		return collab, nil
+	}
+	return nil, nil
+}
+
+func (c *Client) setCollaborator(projectID, email string, role pb.ProjectRole) error {
+	_, err := c.shareProject(&pb.ShareProjectRequest{
+		ProjectId: projectID,
+		Collaborators: []*pb.Collaborator{
+			{Email: email, Role: role},
+		},
+	})
+	return err
+}
+
+// checkShareRole reports whether role can be granted to a collaborator.
+func checkShareRole(role pb.ProjectRole) error {
+	switch role {
+	case pb.ProjectRole_PROJECT_ROLE_VIEWER, pb.ProjectRole_PROJECT_ROLE_EDITOR:
+		return nil
+	}
+	return fmt.Errorf("collaborators can only be viewers or editors, not %v", role)
+}
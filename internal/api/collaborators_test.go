@@ -0,0 +1,109 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+// projectDetails is a notebook owned by owner@example.com and shared with
+// reader@example.com.
+const projectDetails = `["nb1","Notebook","owner@example.com",1,null,[["owner@example.com",1],["reader@example.com",3]]]`
+
+func TestSetCollaboratorRole(t *testing.T) {
+	var shared []string // f.req of every ShareProject call
+	c := newTestClient(t, func(call testCall) string {
+		if call.rpcID == pb.NotebookLMSharing_ShareProject_RPCID {
+			shared = append(shared, call.freq)
+			return `[]`
+		}
+		return projectDetails
+	})
+
+	if err := c.SetCollaboratorRole("nb1", "Reader@example.com", pb.ProjectRole_PROJECT_ROLE_EDITOR); err != nil {
+		t.Fatalf("SetCollaboratorRole() error = %v", err)
+	}
+	want := `[[["QDyure","[\"nb1\",null,[[\"reader@example.com\",2]]]",null,"generic"]]]`
+	if len(shared) != 1 || shared[0] != want {
+		t.Errorf("ShareProject f.req = %q, want [%q]", shared, want)
+	}
+
+	tests := []struct {
+		name  string
+		email string
+		role  pb.ProjectRole
+	}{
+		{"not a collaborator", "stranger@example.com", pb.ProjectRole_PROJECT_ROLE_VIEWER},
+		{"owner", "owner@example.com", pb.ProjectRole_PROJECT_ROLE_VIEWER},
+		{"owner role", "reader@example.com", pb.ProjectRole_PROJECT_ROLE_OWNER},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := c.SetCollaboratorRole("nb1", tt.email, tt.role); err == nil {
+				t.Error("SetCollaboratorRole() succeeded, want error")
+			}
+		})
+	}
+	if len(shared) != 1 {
+		t.Errorf("ShareProject called %d times, want 1", len(shared))
+	}
+}
+
+func TestRemoveCollaborator(t *testing.T) {
+	var shared []string // f.req of every ShareProject call
+	c := newTestClient(t, func(call testCall) string {
+		if call.rpcID == pb.NotebookLMSharing_ShareProject_RPCID {
+			shared = append(shared, call.freq)
+			return `[]`
+		}
+		return projectDetails
+	})
+
+	if err := c.RemoveCollaborator("nb1", "READER@example.com"); err != nil {
+		t.Fatalf("RemoveCollaborator() error = %v", err)
+	}
+	want := `[[["QDyure","[\"nb1\",null,[[\"reader@example.com\",4]]]",null,"generic"]]]`
+	if len(shared) != 1 || shared[0] != want {
+		t.Errorf("ShareProject f.req = %q, want [%q]", shared, want)
+	}
+
+	for _, email := range []string{"stranger@example.com", "owner@example.com"} {
+		if err := c.RemoveCollaborator("nb1", email); err == nil {
+			t.Errorf("RemoveCollaborator(%q) succeeded, want error", email)
+		}
+	}
+	if len(shared) != 1 {
+		t.Errorf("ShareProject called %d times, want 1", len(shared))
+	}
+}
+
+func TestAddCollaborator(t *testing.T) {
+	var shared []string // f.req of every ShareProject call
+	c := newTestClient(t, func(call testCall) string {
+		if call.rpcID == pb.NotebookLMSharing_ShareProject_RPCID {
+			shared = append(shared, call.freq)
+			return `[]`
+		}
+		return projectDetails
+	})
+
+	editor := pb.ProjectRole_PROJECT_ROLE_EDITOR
+	if err := c.AddCollaborator("nb1", "new@example.com", editor); err != nil {
+		t.Fatalf("AddCollaborator() error = %v", err)
+	}
+	// An existing collaborator keeps a single entry with the new role.
+	if err := c.AddCollaborator("nb1", "Reader@Example.com", editor); err != nil {
+		t.Fatalf("AddCollaborator() for a collaborator error = %v", err)
+	}
+	if err := c.AddCollaborator("nb1", "owner@example.com", editor); err == nil {
+		t.Error("AddCollaborator() for the owner succeeded, want error")
+	}
+	want := []string{
+		`[[["QDyure","[\"nb1\",null,[[\"new@example.com\",2]]]",null,"generic"]]]`,
+		`[[["QDyure","[\"nb1\",null,[[\"reader@example.com\",2]]]",null,"generic"]]]`,
+	}
+	if diff := cmp.Diff(want, shared); diff != "" {
+		t.Errorf("ShareProject calls mismatch (-want +got):\n%s", diff)
+	}
+}
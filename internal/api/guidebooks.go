@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+)
+
+// Guidebook operations
+
+func (c *Client) ListRecentlyViewedGuidebooks() ([]*pb.Guidebook, error) {
+	resp, err := c.guidebooks.ListRecentlyViewedGuidebooks(context.Background(), &pb.ListRecentlyViewedGuidebooksRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("list guidebooks: %w", err)
+	}
+	return resp.Guidebooks, nil
+}
+
+func (c *Client) GetGuidebook(guidebookID string) (*pb.Guidebook, error) {
+	guidebook, err := c.guidebooks.GetGuidebook(context.Background(), &pb.GetGuidebookRequest{
+		GuidebookId: guidebookID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get guidebook: %w", err)
+	}
+	return guidebook, nil
+}
+
+func (c *Client) GetGuidebookDetails(guidebookID string) (*pb.GuidebookDetails, error) {
+	details, err := c.guidebooks.GetGuidebookDetails(context.Background(), &pb.GetGuidebookDetailsRequest{
+		GuidebookId: guidebookID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get guidebook details: %w", err)
+	}
+	return details, nil
+}
+
+// PublishGuidebook publishes a notebook as a guidebook. An empty title keeps
+// the notebook's title.
+func (c *Client) PublishGuidebook(projectID, title string) (*pb.Guidebook, error) {
+	guidebook, err := c.guidebooks.PublishGuidebook(context.Background(), &pb.PublishGuidebookRequest{
+		ProjectId: projectID,
+		Title:     title,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("publish guidebook: %w", err)
+	}
+	return guidebook, nil
+}
+
+// ShareGuidebook sets who can open a guidebook through its share link and
+// returns the link.
+func (c *Client) ShareGuidebook(guidebookID string, access pb.ProjectAccess) (string, error) {
+	resp, err := c.guidebooks.ShareGuidebook(context.Background(), &pb.ShareGuidebookRequest{
+		GuidebookId: guidebookID,
+		Access:      access,
+	})
+	if err != nil {
+		return "", fmt.Errorf("share guidebook: %w", err)
+	}
+	return resp.ShareUrl, nil
+}
+
+func (c *Client) DeleteGuidebook(guidebookID string) error {
+	if _, err := c.guidebooks.DeleteGuidebook(context.Background(), &pb.DeleteGuidebookRequest{
+		GuidebookId: guidebookID,
+	}); err != nil {
+		return fmt.Errorf("delete guidebook: %w", err)
+	}
+	return nil
+}
+
+// GuidebookGenerateAnswer asks a question of a published guidebook.
+func (c *Client) GuidebookGenerateAnswer(guidebookID, question string) (string, error) {
+	resp, err := c.guidebooks.GuidebookGenerateAnswer(context.Background(), &pb.GuidebookGenerateAnswerRequest{
+		GuidebookId: guidebookID,
+		Question:    question,
+	})
+	if err != nil {
+		return "", fmt.Errorf("generate answer: %w", err)
+	}
+	return resp.Answer, nil
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/zbigniew-malinowski/nlm/internal/batchexecute"
+)
+
+// DefaultKeepaliveInterval is used when Keepalive.Interval is zero.
+const DefaultKeepaliveInterval = 5 * time.Minute
+
+// Keepalive configures background session heartbeats.
+type Keepalive struct {
+	// Interval between heartbeats. Defaults to DefaultKeepaliveInterval.
+	Interval time.Duration
+	// OnExpired is called once when a heartbeat finds the session expired.
+	// Heartbeats stop afterwards.
+	OnExpired func(error)
+	// OnError is called for any other failed heartbeat. Optional.
+	OnError func(error)
+}
+
+// Heartbeat sends a single heartbeat to keep the session alive.
+func (c *Client) Heartbeat() error {
+	return c.rpc.Heartbeat()
+}
+
+// StartKeepalive sends heartbeats in the background until ctx is done, the
+// session expires or the returned stop function is called. Stop waits for
+// the background goroutine to exit.
+func (c *Client) StartKeepalive(ctx context.Context, k Keepalive) (stop func()) {
+	interval := k.Interval
+	if interval <= 0 {
+		interval = DefaultKeepaliveInterval
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			err := c.rpc.HeartbeatContext(ctx)
+			switch {
+			case err == nil, ctx.Err() != nil:
+			case errors.Is(err, batchexecute.ErrUnauthorized):
+				if k.OnExpired != nil {
+					k.OnExpired(err)
+				}
+				return
+			case k.OnError != nil:
+				k.OnError(err)
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		wg.Wait()
+	}
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zbigniew-malinowski/nlm/internal/batchexecute"
+)
+
+func TestStartKeepalive(t *testing.T) {
+	// Heartbeats send the same request as listing notebooks, which is made
+	// first and recorded in listed.
+	var listed string
+	var calls atomic.Int32
+	c := newTestClient(t, func(call testCall) string {
+		if listed == "" {
+			listed = call.freq
+			return `[]`
+		}
+		if call.freq != listed {
+			t.Errorf("heartbeat f.req = %s, want the ListRecentlyViewedProjects request %s", call.freq, listed)
+		}
+		// The first heartbeat succeeds, the second finds the session expired.
+		if calls.Add(1) > 1 {
+			return expiredSession
+		}
+		return `[]`
+	})
+
+	if _, err := c.ListRecentlyViewedProjects(); err != nil {
+		t.Fatalf("ListRecentlyViewedProjects() error = %v", err)
+	}
+
+	expired := make(chan error, 1)
+	stop := c.StartKeepalive(context.Background(), Keepalive{
+		Interval:  10 * time.Millisecond,
+		OnExpired: func(err error) { expired <- err },
+		OnError:   func(err error) { t.Errorf("unexpected heartbeat error: %v", err) },
+	})
+	defer stop()
+
+	select {
+	case err := <-expired:
+		if !errors.Is(err, batchexecute.ErrUnauthorized) {
+			t.Errorf("OnExpired error = %v, want ErrUnauthorized", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnExpired was not called")
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("heartbeats sent = %d, want 2", got)
+	}
+}
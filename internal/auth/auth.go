@@ -32,12 +32,27 @@ func New(debug bool) *BrowserAuth {
 
 type Options struct {
 	ProfileName string
+
+	// Capture, if set, receives a CaptureRecord for every batchexecute
+	// request the browser makes. After authenticating, the browser stays
+	// open until CaptureContext is done or the browser is closed.
+	Capture        io.Writer
+	CaptureContext context.Context
 }
 
 type Option func(*Options)
 
 func WithProfileName(p string) Option { return func(o *Options) { o.ProfileName = p } }
 
+// WithCapture records batchexecute traffic to w as JSON lines until ctx is
+// done. It always shows the browser window so the web app can be used.
+func WithCapture(ctx context.Context, w io.Writer) Option {
+	return func(o *Options) {
+		o.Capture = w
+		o.CaptureContext = ctx
+	}
+}
+
 func (ba *BrowserAuth) GetAuth(opts ...Option) (token, cookies string, err error) {
 	o := &Options{
 		ProfileName: "Default",
@@ -45,6 +60,9 @@ func (ba *BrowserAuth) GetAuth(opts ...Option) (token, cookies string, err error
 	for _, opt := range opts {
 		opt(o)
 	}
+	if o.Capture != nil && o.CaptureContext == nil {
+		o.CaptureContext = context.Background()
+	}
 
 	defer ba.cleanup()
 
@@ -66,6 +84,13 @@ func (ba *BrowserAuth) GetAuth(opts ...Option) (token, cookies string, err error
 	var cancel context.CancelFunc
 	var debugURL string
 
+	var ctxOpts []chromedp.ContextOption
+	if ba.debug {
+		ctxOpts = append(ctxOpts, chromedp.WithLogf(func(format string, args ...interface{}) {
+			fmt.Printf("ChromeDP: "+format+"\n", args...)
+		}))
+	}
+
 	if ba.useExec {
 		// Use original exec.Command approach
 		debugURL, err = ba.startChromeExec()
@@ -74,7 +99,7 @@ func (ba *BrowserAuth) GetAuth(opts ...Option) (token, cookies string, err error
 		}
 		allocCtx, allocCancel := chromedp.NewRemoteAllocator(context.Background(), debugURL)
 		ba.cancel = allocCancel
-		ctx, cancel = chromedp.NewContext(allocCtx)
+		ctx, cancel = chromedp.NewContext(allocCtx, ctxOpts...)
 	} else {
 		// Use chromedp.ExecAllocator approach
 		opts := []chromedp.ExecAllocatorOption{
@@ -86,7 +111,7 @@ func (ba *BrowserAuth) GetAuth(opts ...Option) (token, cookies string, err error
 			chromedp.Flag("disable-popup-blocking", true),
 			chromedp.Flag("window-size", "1280,800"),
 			chromedp.UserDataDir(ba.tempDir),
-			chromedp.Flag("headless", !ba.debug),
+			chromedp.Flag("headless", !ba.debug && o.Capture == nil),
 			chromedp.Flag("disable-hang-monitor", true),
 			chromedp.Flag("disable-ipc-flooding-protection", true),
 			chromedp.Flag("disable-popup-blocking", true),
@@ -102,10 +127,22 @@ func (ba *BrowserAuth) GetAuth(opts ...Option) (token, cookies string, err error
 
 		allocCtx, allocCancel := chromedp.NewExecAllocator(context.Background(), opts...)
 		ba.cancel = allocCancel
-		ctx, cancel = chromedp.NewContext(allocCtx)
+		ctx, cancel = chromedp.NewContext(allocCtx, ctxOpts...)
 	}
 	defer cancel()
 
+	var rec *recorder
+	if o.Capture != nil {
+		rec = newRecorder(o.Capture, ba.debug)
+		rec.listen(ctx)
+		// Start the browser on the untimed context so that it outlives the
+		// authentication deadline below.
+		if err := chromedp.Run(ctx); err != nil {
+			return "", "", fmt.Errorf("start browser: %w", err)
+		}
+	}
+	tabCtx := ctx
+
 	// Allow ample time for the user to complete any interactive
 	// authentication flows in the browser before we give up.  The
 	// previous 60s deadline was too short and caused "context deadline
@@ -114,13 +151,20 @@ func (ba *BrowserAuth) GetAuth(opts ...Option) (token, cookies string, err error
 	ctx, cancel = context.WithTimeout(ctx, 5*time.Minute)
 	defer cancel()
 
-	if ba.debug {
-		ctx, _ = chromedp.NewContext(ctx, chromedp.WithLogf(func(format string, args ...interface{}) {
-			fmt.Printf("ChromeDP: "+format+"\n", args...)
-		}))
+	token, cookies, err = ba.extractAuthData(ctx)
+	if err != nil || rec == nil {
+		return token, cookies, err
 	}
 
-	return ba.extractAuthData(ctx)
+	fmt.Fprintln(os.Stderr, "nlm: capturing batchexecute traffic; use NotebookLM in the browser, then close it or press Ctrl-C")
+	select {
+	case <-o.CaptureContext.Done():
+	case <-tabCtx.Done():
+	}
+	if err := rec.wait(); err != nil {
+		return "", "", err
+	}
+	return token, cookies, nil
 }
 
 func (ba *BrowserAuth) copyProfileData(profileName string) error {
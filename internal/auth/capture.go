@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chromedp/cdproto/network"
+	"github.com/chromedp/chromedp"
+	"github.com/zbigniew-malinowski/nlm/internal/batchexecute"
+)
+
+// CaptureRecord is a single batchexecute exchange observed in the browser.
+// Records are written as one JSON object per line.
+type CaptureRecord struct {
+	Time       time.Time               `json:"time"`
+	URL        string                  `json:"url"`
+	RPCIDs     []string                `json:"rpcids"`
+	SourcePath string                  `json:"source_path,omitempty"`
+	Status     int64                   `json:"status,omitempty"`
+	Requests   []CapturedRPC           `json:"requests,omitempty"`
+	Responses  []batchexecute.Response `json:"responses,omitempty"`
+	Error      string                  `json:"error,omitempty"`
+}
+
+// CapturedRPC is one call decoded from a request's f.req envelope.
+type CapturedRPC struct {
+	ID    string        `json:"id"`
+	Args  []interface{} `json:"args"`
+	Index string        `json:"index,omitempty"`
+}
+
+// recorder writes batchexecute traffic seen on a chromedp target.
+type recorder struct {
+	debug bool
+
+	mu      sync.Mutex
+	enc     *json.Encoder
+	err     error
+	pending map[network.RequestID]*CaptureRecord
+	wg      sync.WaitGroup
+}
+
+func newRecorder(w io.Writer, debug bool) *recorder {
+	return &recorder{
+		debug:   debug,
+		enc:     json.NewEncoder(w),
+		pending: make(map[network.RequestID]*CaptureRecord),
+	}
+}
+
+// listen subscribes to network events on the target of ctx. It must be
+// called before the target is first used so no early requests are missed.
+func (r *recorder) listen(ctx context.Context) {
+	chromedp.ListenTarget(ctx, func(ev interface{}) {
+		switch ev := ev.(type) {
+		case *network.EventRequestWillBeSent:
+			if !strings.Contains(ev.Request.URL, "/batchexecute") {
+				return
+			}
+			rec := &CaptureRecord{Time: time.Now(), URL: ev.Request.URL}
+			if u, err := url.Parse(ev.Request.URL); err == nil {
+				q := u.Query()
+				if ids := q.Get("rpcids"); ids != "" {
+					rec.RPCIDs = strings.Split(ids, ",")
+				}
+				rec.SourcePath = q.Get("source-path")
+			}
+			r.mu.Lock()
+			r.pending[ev.RequestID] = rec
+			r.mu.Unlock()
+
+		case *network.EventResponseReceived:
+			r.mu.Lock()
+			if rec, ok := r.pending[ev.RequestID]; ok {
+				rec.Status = ev.Response.Status
+			}
+			r.mu.Unlock()
+
+		case *network.EventLoadingFailed:
+			if rec := r.take(ev.RequestID); rec != nil {
+				rec.Error = ev.ErrorText
+				r.write(rec)
+			}
+
+		case *network.EventLoadingFinished:
+			rec := r.take(ev.RequestID)
+			if rec == nil {
+				return
+			}
+			// Listeners must not block, so the bodies are fetched from a
+			// separate goroutine.
+			r.wg.Add(1)
+			go func(id network.RequestID) {
+				defer r.wg.Done()
+				r.complete(ctx, id, rec)
+				r.write(rec)
+			}(ev.RequestID)
+		}
+	})
+}
+
+func (r *recorder) take(id network.RequestID) *CaptureRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rec := r.pending[id]
+	delete(r.pending, id)
+	return rec
+}
+
+// complete fills in the decoded request and response of rec.
+func (r *recorder) complete(ctx context.Context, id network.RequestID, rec *CaptureRecord) {
+	var postData string
+	var body []byte
+	err := chromedp.Run(ctx, chromedp.ActionFunc(func(ctx context.Context) error {
+		var err error
+		if postData, err = network.GetRequestPostData(id).Do(ctx); err != nil {
+			return fmt.Errorf("get request body: %w", err)
+		}
+		if body, err = network.GetResponseBody(id).Do(ctx); err != nil {
+			return fmt.Errorf("get response body: %w", err)
+		}
+		return nil
+	}))
+	if err != nil {
+		rec.Error = err.Error()
+		return
+	}
+
+	form, err := url.ParseQuery(postData)
+	if err != nil {
+		rec.Error = fmt.Sprintf("parse request body: %v", err)
+		return
+	}
+	rpcs, err := batchexecute.DecodeRequest(form.Get("f.req"))
+	if err != nil {
+		rec.Error = err.Error()
+		return
+	}
+	for _, rpc := range rpcs {
+		rec.Requests = append(rec.Requests, CapturedRPC{ID: rpc.ID, Args: rpc.Args, Index: rpc.Index})
+	}
+
+	if rec.Responses, err = batchexecute.DecodeResponse(string(body)); err != nil {
+		rec.Error = err.Error()
+	}
+}
+
+func (r *recorder) write(rec *CaptureRecord) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return
+	}
+	if r.debug {
+		fmt.Printf("Captured %s (status %d)\n", strings.Join(rec.RPCIDs, ","), rec.Status)
+	}
+	if err := r.enc.Encode(rec); err != nil {
+		r.err = fmt.Errorf("write capture: %w", err)
+	}
+}
+
+// wait blocks until in-flight records are written and returns the first
+// write error, if any.
+func (r *recorder) wait() error {
+	r.wg.Wait()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
@@ -2,6 +2,7 @@ package batchexecute
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -55,7 +56,12 @@ func (e *BatchExecuteError) Unwrap() error {
 
 // Do executes a single RPC call
 func (c *Client) Do(rpc RPC) (*Response, error) {
-	return c.Execute([]RPC{rpc})
+	return c.DoContext(context.Background(), rpc)
+}
+
+// DoContext executes a single RPC call with the given context
+func (c *Client) DoContext(ctx context.Context, rpc RPC) (*Response, error) {
+	return c.ExecuteContext(ctx, []RPC{rpc})
 }
 
 func buildRPCData(rpc RPC) []interface{} {
@@ -72,6 +78,11 @@ func buildRPCData(rpc RPC) []interface{} {
 
 // Execute performs the batch execute request
 func (c *Client) Execute(rpcs []RPC) (*Response, error) {
+	return c.ExecuteContext(context.Background(), rpcs)
+}
+
+// ExecuteContext performs the batch execute request with the given context
+func (c *Client) ExecuteContext(ctx context.Context, rpcs []RPC) (*Response, error) {
 	u, err := url.Parse(fmt.Sprintf("https://%s/_/%s/data/batchexecute", c.config.Host, c.config.App))
 	if err != nil {
 		return nil, fmt.Errorf("parse url: %w", err)
@@ -124,7 +135,7 @@ func (c *Client) Execute(rpcs []RPC) (*Response, error) {
 	}
 
 	// Create request
-	req, err := http.NewRequest("POST", u.String(), strings.NewReader(form.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", u.String(), strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("create request: %w", err)
 	}
@@ -168,19 +179,9 @@ func (c *Client) Execute(rpcs []RPC) (*Response, error) {
 		}
 	}
 
-	// Parse chunked response
-	responses, err := decodeChunkedResponse(string(body))
+	responses, err := DecodeResponse(string(body))
 	if err != nil {
-		if c.config.Debug {
-			fmt.Printf("Failed to decode chunked response: %v\n", err)
-		}
-		// Fallback to regular response parsing
-		tmp, err2 := decodeResponse(string(body))
-		if err2 != nil {
-			return nil, fmt.Errorf("decode chunked response: %v; decode response: %w", err, err2)
-		}
-		responses = tmp
-		err = nil
+		return nil, err
 	}
 
 	if len(responses) == 0 {
@@ -192,6 +193,55 @@ func (c *Client) Execute(rpcs []RPC) (*Response, error) {
 
 var debug = true
 
+// DecodeRequest decodes the f.req form value of a batchexecute request into
+// the RPC calls it carries.
+func DecodeRequest(freq string) ([]RPC, error) {
+	var envelope [][][]interface{}
+	if err := json.Unmarshal([]byte(freq), &envelope); err != nil {
+		return nil, fmt.Errorf("decode f.req: %w", err)
+	}
+	if len(envelope) == 0 {
+		return nil, fmt.Errorf("decode f.req: empty envelope")
+	}
+
+	var rpcs []RPC
+	for _, rpcData := range envelope[0] {
+		if len(rpcData) < 2 {
+			continue
+		}
+		id, _ := rpcData[0].(string)
+		rpc := RPC{ID: id}
+
+		// Arguments are sent as a JSON-encoded string.
+		if argsJSON, ok := rpcData[1].(string); ok && argsJSON != "" {
+			dec := json.NewDecoder(strings.NewReader(argsJSON))
+			dec.UseNumber()
+			if err := dec.Decode(&rpc.Args); err != nil {
+				return nil, fmt.Errorf("decode args for %s: %w", id, err)
+			}
+		}
+		if len(rpcData) > 3 {
+			rpc.Index, _ = rpcData[3].(string)
+		}
+		rpcs = append(rpcs, rpc)
+	}
+	return rpcs, nil
+}
+
+// DecodeResponse decodes a batchexecute response body. Chunked (rt=c)
+// responses are tried first, falling back to the plain format.
+func DecodeResponse(raw string) ([]Response, error) {
+	responses, err := decodeChunkedResponse(raw)
+	if err == nil {
+		return responses, nil
+	}
+	responses, err2 := decodeResponse(raw)
+	if err2 != nil {
+		return nil, fmt.Errorf("decode chunked response: %v; decode response: %w", err, err2)
+	}
+	return responses, nil
+}
+
 // decodeResponse decodes the batchexecute response
 func decodeResponse(raw string) ([]Response, error) {
 	raw = strings.TrimPrefix(raw, ")]}'")
@@ -188,6 +188,48 @@ func TestDecodeResponse(t *testing.T) {
 	}
 }
 
+func TestDecodeRequest(t *testing.T) {
+	testCases := []struct {
+		name     string
+		freq     string
+		expected []RPC
+		wantErr  bool
+	}{
+		{
+			name: "Single RPC",
+			freq: `[[["wXbhsf","[null,1]",null,"generic"]]]`,
+			expected: []RPC{
+				{ID: "wXbhsf", Args: []interface{}{nil, json.Number("1")}, Index: "generic"},
+			},
+		},
+		{
+			name: "Batched RPCs",
+			freq: `[[["rLM1Ne","[\"nb1\"]",null,"1"],["cFji9","[\"nb1\"]",null,"2"]]]`,
+			expected: []RPC{
+				{ID: "rLM1Ne", Args: []interface{}{"nb1"}, Index: "1"},
+				{ID: "cFji9", Args: []interface{}{"nb1"}, Index: "2"},
+			},
+		},
+		{
+			name:    "Invalid JSON",
+			freq:    `[[["wXbhsf"`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := DecodeRequest(tc.freq)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("DecodeRequest() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.expected, actual); diff != "" {
+				t.Errorf("RPC mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestExecute(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		t.Log("Received request")
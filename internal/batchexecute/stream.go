@@ -0,0 +1,165 @@
+package batchexecute
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// StreamRequest is a call to a streaming endpoint served next to
+// batchexecute, such as a gRPC-style method whose answer is sent in several
+// frames while it is generated.
+type StreamRequest struct {
+	Method    string            // method path, e.g. "pkg.Service/Method"
+	Args      []interface{}     // arguments, sent as f.req [null, args]
+	URLParams map[string]string // request-specific URL parameters
+}
+
+// Stream executes a streaming call and calls fn with the payload of every
+// frame as it arrives.
+func (c *Client) Stream(req StreamRequest, fn func(json.RawMessage) error) error {
+	return c.StreamContext(context.Background(), req, fn)
+}
+
+// StreamContext executes a streaming call with the given context and calls
+// fn with the payload of every frame as it arrives. An error returned by fn
+// stops the stream and is returned as is.
+func (c *Client) StreamContext(ctx context.Context, req StreamRequest, fn func(json.RawMessage) error) error {
+	u, err := url.Parse(fmt.Sprintf("https://%s/_/%s/data/%s", c.config.Host, c.config.App, req.Method))
+	if err != nil {
+		return fmt.Errorf("parse url: %w", err)
+	}
+	if c.config.UseHTTP {
+		u.Scheme = "http"
+	}
+
+	q := u.Query()
+	for k, v := range c.config.URLParams {
+		q.Set(k, v)
+	}
+	for k, v := range req.URLParams {
+		q.Set(k, v)
+	}
+	q.Set("rt", "c")
+	q.Set("_reqid", c.reqid.Next())
+	u.RawQuery = q.Encode()
+
+	argsJSON, err := json.Marshal(req.Args)
+	if err != nil {
+		return fmt.Errorf("marshal args: %w", err)
+	}
+	reqBody, err := json.Marshal([]interface{}{nil, string(argsJSON)})
+	if err != nil {
+		return fmt.Errorf("marshal request body: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("f.req", string(reqBody))
+	form.Set("at", c.config.AuthToken)
+
+	if c.config.Debug {
+		fmt.Printf("\n=== Stream Request ===\n")
+		fmt.Printf("URL: %s\n", u.String())
+		fmt.Printf("\nDecoded Request Body:\n%s\n", string(reqBody))
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", u.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	httpReq.Header.Set("content-type", "application/x-www-form-urlencoded;charset=UTF-8")
+	for k, v := range c.config.Headers {
+		httpReq.Header.Set(k, v)
+	}
+	httpReq.Header.Set("cookie", c.config.Cookies)
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return &BatchExecuteError{
+			StatusCode: resp.StatusCode,
+			Message:    fmt.Sprintf("request failed: %s", resp.Status),
+			Response:   resp,
+		}
+	}
+
+	return readFrames(resp.Body, func(data json.RawMessage) error {
+		if c.config.Debug {
+			fmt.Printf("\nStream Frame:\n%s\n", string(data))
+		}
+		return fn(data)
+	})
+}
+
+// readFrames reads a chunked response and calls fn with the payload of every
+// wrb.fr entry. Each chunk is a length line followed by a single line of
+// JSON; the JSON line is read as a whole rather than by the declared length,
+// which the server counts in UTF-16 code units.
+func readFrames(r io.Reader, fn func(json.RawMessage) error) error {
+	reader := bufio.NewReader(r)
+	seen := false
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("read stream: %w", err)
+		}
+		line = strings.TrimSpace(line)
+		if line != "" && line != ")]}'" {
+			if _, convErr := strconv.Atoi(line); convErr != nil {
+				n, frameErr := handleFrame([]byte(line), fn)
+				if frameErr != nil {
+					return frameErr
+				}
+				seen = seen || n > 0
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+	if !seen {
+		return fmt.Errorf("no valid responses found")
+	}
+	return nil
+}
+
+// handleFrame calls fn for every wrb.fr entry of a chunk and returns how
+// many were found.
+func handleFrame(chunk []byte, fn func(json.RawMessage) error) (int, error) {
+	var entries [][]interface{}
+	if err := json.Unmarshal(chunk, &entries); err != nil {
+		return 0, fmt.Errorf("parse chunk: %w", err)
+	}
+	n := 0
+	for _, entry := range entries {
+		if len(entry) < 3 {
+			continue
+		}
+		if entry[0] != "wrb.fr" {
+			continue
+		}
+		data, ok := entry[2].(string)
+		if !ok {
+			// An entry without payload carries an error code instead.
+			if len(entry) > 5 && entry[5] != nil {
+				return n, fmt.Errorf("stream error: %v", entry[5])
+			}
+			continue
+		}
+		n++
+		if err := fn(json.RawMessage(data)); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
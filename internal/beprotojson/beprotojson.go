@@ -20,8 +20,76 @@ func Marshal(m proto.Message) ([]byte, error) {
 
 // Marshal writes the given proto.Message in batchexecute JSON format using options in MarshalOptions.
 func (o MarshalOptions) Marshal(m proto.Message) ([]byte, error) {
-	// TODO: implement
-	return nil, fmt.Errorf("not implemented")
+	arr, err := o.marshalMessage(m.ProtoReflect())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(arr)
+}
+
+// marshalMessage lays out the populated fields of m by field number. Unset
+// fields become null and trailing unset fields are omitted.
+func (o MarshalOptions) marshalMessage(m protoreflect.Message) ([]interface{}, error) {
+	var arr []interface{}
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		var val interface{}
+		if val, err = o.marshalField(fd, v); err != nil {
+			err = fmt.Errorf("beprotojson: field %s: %w", fd.Name(), err)
+			return false
+		}
+		pos := int(fd.Number())
+		for len(arr) < pos {
+			arr = append(arr, nil)
+		}
+		arr[pos-1] = val
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if arr == nil {
+		arr = []interface{}{}
+	}
+	return arr, nil
+}
+
+func (o MarshalOptions) marshalField(fd protoreflect.FieldDescriptor, v protoreflect.Value) (interface{}, error) {
+	switch {
+	case fd.IsMap():
+		return nil, fmt.Errorf("map fields are not supported")
+	case fd.IsList():
+		list := v.List()
+		arr := make([]interface{}, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			item, err := o.marshalValue(fd, list.Get(i))
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, item)
+		}
+		return arr, nil
+	default:
+		return o.marshalValue(fd, v)
+	}
+}
+
+func (o MarshalOptions) marshalValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) (interface{}, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		msg := v.Message()
+		// Wrapper types are encoded as their bare value, mirroring Unmarshal.
+		if isWrapperType(fd.Message().FullName()) {
+			return o.marshalValue(fd.Message().Fields().ByName("value"), msg.Get(fd.Message().Fields().ByName("value")))
+		}
+		return o.marshalMessage(msg)
+	case protoreflect.EnumKind:
+		return int32(v.Enum()), nil
+	case protoreflect.BytesKind:
+		return string(v.Bytes()), nil
+	default:
+		return v.Interface(), nil
+	}
 }
 
 // UnmarshalOptions is a configurable JSON format parser.
@@ -1,4 +1,4 @@
-package beprotojson
+package beprotojson_test
 
 import (
 	"testing"
@@ -11,6 +11,7 @@ import (
 
 	// TODO: separate from anything nlm related
 	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/beprotojson"
 )
 
 func TestUnmarshal(t *testing.T) {
@@ -102,8 +103,9 @@ func TestUnmarshal(t *testing.T) {
 				},
 				Emoji: "🕵️",
 				Metadata: &pb.ProjectMetadata{
-					UserRole: 1,
-					Type:     1,
+					UserRole:  pb.ProjectRole_PROJECT_ROLE_OWNER,
+					Type:      1,
+					IsStarred: proto.Bool(false),
 					CreateTime: &timestamppb.Timestamp{
 						Seconds: 1731827837,
 						Nanos:   76688000,
@@ -115,6 +117,18 @@ func TestUnmarshal(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "notebook guide with suggested questions",
+			json: `["# Guide", "A short summary.", [["What is X?", "Explain X in detail."], ["Why Y?"]]]`,
+			want: &pb.GenerateNotebookGuideResponse{
+				Content: "# Guide",
+				Summary: "A short summary.",
+				SuggestedQuestions: []*pb.SuggestedQuestion{
+					{Question: "What is X?", Prompt: "Explain X in detail."},
+					{Question: "Why Y?"},
+				},
+			},
+		},
 		{
 			name:    "invalid json",
 			json:    `not json`,
@@ -125,8 +139,8 @@ func TestUnmarshal(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := &pb.Project{}
-			err := Unmarshal([]byte(tt.json), got)
+			got := tt.want.ProtoReflect().New().Interface()
+			err := beprotojson.Unmarshal([]byte(tt.json), got)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -145,22 +159,23 @@ func TestUnmarshal(t *testing.T) {
 func TestUnmarshalOptions(t *testing.T) {
 	tests := []struct {
 		name    string
-		opts    UnmarshalOptions
+		opts    beprotojson.UnmarshalOptions
 		json    string
 		want    *pb.Project // Changed to concrete type
 		wantErr bool
 	}{
 		{
 			name: "discard unknown fields",
-			opts: UnmarshalOptions{DiscardUnknown: true},
+			opts: beprotojson.UnmarshalOptions{DiscardUnknown: true},
 			json: `["project1", [], "id1", "📚", null, [1, false, true, null, null, [1731910459, 665561000], 1, false, [1731827837, 76688000]]]`,
 			want: &pb.Project{
 				Title:     "project1",
 				ProjectId: "id1",
 				Emoji:     "📚",
 				Metadata: &pb.ProjectMetadata{
-					UserRole: 1,
-					Type:     1,
+					UserRole:  pb.ProjectRole_PROJECT_ROLE_OWNER,
+					Type:      1,
+					IsStarred: proto.Bool(false),
 					CreateTime: &timestamppb.Timestamp{
 						Seconds: 1731827837,
 						Nanos:   76688000,
@@ -174,7 +189,7 @@ func TestUnmarshalOptions(t *testing.T) {
 		},
 		{
 			name:    "fail on unknown fields",
-			opts:    UnmarshalOptions{DiscardUnknown: false},
+			opts:    beprotojson.UnmarshalOptions{DiscardUnknown: false},
 			json:    `["project1", [], "My Project", "📚", "extra"]`,
 			want:    &pb.Project{},
 			wantErr: true,
@@ -200,10 +215,96 @@ func TestUnmarshalOptions(t *testing.T) {
 	}
 }
 
+func TestMarshal(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  proto.Message
+		want string
+	}{
+		{
+			name: "empty message",
+			msg:  &pb.Project{},
+			want: `[]`,
+		},
+		{
+			name: "unset fields become null",
+			msg: &pb.Project{
+				Title:     "project1",
+				ProjectId: "id1",
+			},
+			want: `["project1",null,"id1"]`,
+		},
+		{
+			name: "nested messages, enums and wrappers",
+			msg: &pb.Source{
+				SourceId: &pb.SourceId{SourceId: "source1"},
+				Title:    "Source One",
+				Metadata: &pb.SourceMetadata{
+					LastUpdateTimeSeconds: &wrapperspb.Int32Value{Value: 15108},
+					LastModifiedTime: &timestamppb.Timestamp{
+						Seconds: 1728034802,
+						Nanos:   578385000,
+					},
+					SourceType: pb.SourceType_SOURCE_TYPE_YOUTUBE_VIDEO,
+				},
+				Settings: &pb.SourceSettings{
+					Status: pb.SourceSettings_SOURCE_STATUS_DISABLED,
+				},
+			},
+			want: `[["source1"],"Source One",[null,15108,[1728034802,578385000],null,9],[null,2]]`,
+		},
+		{
+			name: "repeated fields",
+			msg: &pb.ListRecentlyViewedProjectsResponse{
+				Projects: []*pb.Project{
+					{Title: "a"},
+					{Title: "b", Emoji: "📚"},
+				},
+			},
+			want: `[[["a"],["b",null,null,"📚"]]]`,
+		},
+		{
+			name: "partial update with explicit false",
+			msg: &pb.MutateProjectRequest{
+				ProjectId: "id1",
+				Updates: &pb.Project{
+					Metadata: &pb.ProjectMetadata{IsStarred: proto.Bool(false)},
+				},
+			},
+			want: `["id1",[null,null,null,null,null,[null,null,null,null,null,null,null,false]]]`,
+		},
+		{
+			name: "chat configuration update",
+			msg: &pb.MutateProjectRequest{
+				ProjectId: "id1",
+				Updates: &pb.Project{
+					Config: &pb.ChatbotConfig{
+						Goal:           pb.ConversationalGoal_CONVERSATIONAL_GOAL_CUSTOM,
+						CustomPrompt:   "Answer as a tutor.",
+						ResponseLength: pb.ResponseLength_RESPONSE_LENGTH_LONGER,
+					},
+					AdvancedSettings: &pb.AdvancedSettings{OutputLanguage: wrapperspb.String("de")},
+				},
+			},
+			want: `["id1",[null,null,null,null,null,null,[3,"Answer as a tutor.",2],["de"]]]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := beprotojson.Marshal(tt.msg)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, string(got)); diff != "" {
+				t.Errorf("Marshal() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 // TestRoundTrip tests marshaling and unmarshaling
 func TestRoundTrip(t *testing.T) {
-	t.Skip("Marshal not implemented yet")
-
 	tests := []struct {
 		name string
 		msg  proto.Message
@@ -216,12 +317,37 @@ func TestRoundTrip(t *testing.T) {
 				Emoji:     "📚",
 			},
 		},
-		// Add more test cases here
+		{
+			name: "project with sources",
+			msg: &pb.Project{
+				Title: "project2",
+				Sources: []*pb.Source{
+					{
+						SourceId: &pb.SourceId{SourceId: "source1"},
+						Title:    "Source One",
+						Metadata: &pb.SourceMetadata{
+							LastUpdateTimeSeconds: &wrapperspb.Int32Value{Value: 2779},
+							SourceType:            pb.SourceType_SOURCE_TYPE_WEB_PAGE,
+						},
+					},
+				},
+				ProjectId: "id2",
+				Emoji:     "📚",
+				Metadata: &pb.ProjectMetadata{
+					UserRole:  pb.ProjectRole_PROJECT_ROLE_OWNER,
+					IsStarred: proto.Bool(true),
+					CreateTime: &timestamppb.Timestamp{
+						Seconds: 1731827837,
+						Nanos:   76688000,
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			data, err := Marshal(tt.msg)
+			data, err := beprotojson.Marshal(tt.msg)
 			if err != nil {
 				t.Fatalf("Marshal() error = %v", err)
 			}
@@ -229,7 +355,7 @@ func TestRoundTrip(t *testing.T) {
 			got := proto.Clone(tt.msg)
 			proto.Reset(got)
 
-			if err := Unmarshal(data, got); err != nil {
+			if err := beprotojson.Unmarshal(data, got); err != nil {
 				t.Fatalf("Unmarshal() error = %v", err)
 			}
 
@@ -0,0 +1,76 @@
+package rpc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Info describes a known NotebookLM RPC endpoint.
+type Info struct {
+	ID      string // RPC endpoint ID
+	Name    string // Method name, e.g. "GetProject"
+	Service string // Service name, e.g. "NotebookLM"
+
+	// ReadOnly reports whether the call leaves server state unchanged.
+	ReadOnly bool
+	// Idempotent reports whether repeating the call is safe, e.g. on retry.
+	Idempotent bool
+	// NotebookScoped reports whether the call expects a NotebookID, which
+	// is sent as the source-path URL parameter.
+	NotebookScoped bool
+	// ResponseType is the full proto message name the response decodes
+	// into, or empty if the response has no known type.
+	ResponseType string
+}
+
+var (
+	registry []Info
+	byID     = make(map[string]Info)
+	byName   = make(map[string]Info)
+)
+
+// Register adds RPCs to the registry. It is called from the init functions
+// of the generated batchexecute clients, so the registry lists every method
+// of the services linked into the binary. Methods may share an RPC ID when
+// one endpoint takes several request layouts; Lookup then returns the first
+// registered. Register panics if a method name is registered twice.
+func Register(infos ...Info) {
+	for _, info := range infos {
+		key := strings.ToLower(info.Name)
+		if _, dup := byName[key]; dup {
+			panic(fmt.Sprintf("rpc: method %s registered twice", info.Name))
+		}
+		byName[key] = info
+		if _, ok := byID[info.ID]; !ok {
+			byID[info.ID] = info
+		}
+		registry = append(registry, info)
+	}
+}
+
+// Lookup returns the metadata registered for an RPC ID.
+func Lookup(id string) (Info, bool) {
+	info, ok := byID[id]
+	return info, ok
+}
+
+// LookupName returns the metadata registered for a method name. The match
+// is case-insensitive.
+func LookupName(name string) (Info, bool) {
+	info, ok := byName[strings.ToLower(name)]
+	return info, ok
+}
+
+// Resolve looks up an RPC by ID, falling back to its method name.
+func Resolve(idOrName string) (Info, bool) {
+	if info, ok := Lookup(idOrName); ok {
+		return info, true
+	}
+	return LookupName(idOrName)
+}
+
+// List returns every registered RPC in registration order, which groups
+// them by service.
+func List() []Info {
+	return append([]Info(nil), registry...)
+}
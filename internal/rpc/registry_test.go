@@ -0,0 +1,63 @@
+package rpc_test
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/zbigniew-malinowski/nlm/gen/notebooklm/v1alpha1"
+	"github.com/zbigniew-malinowski/nlm/internal/rpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func TestRegistry(t *testing.T) {
+	names := make(map[string]bool)
+	for _, info := range rpc.List() {
+		if got, ok := rpc.Lookup(info.ID); !ok || got.ID != info.ID {
+			t.Errorf("Lookup(%q) = %+v, %v", info.ID, got, ok)
+		}
+		if names[info.Name] {
+			t.Errorf("duplicate RPC name %q", info.Name)
+		}
+		names[info.Name] = true
+
+		if info.ReadOnly && !info.Idempotent {
+			t.Errorf("%s: read-only RPCs must be idempotent", info.Name)
+		}
+		if info.ReadOnly && strings.Contains(info.Name, "Generate") {
+			t.Errorf("%s: RPCs that generate content must not be read-only", info.Name)
+		}
+		if info.ResponseType != "" {
+			if _, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(info.ResponseType)); err != nil {
+				t.Errorf("%s: response type %q: %v", info.Name, info.ResponseType, err)
+			}
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: rpc.RPCGetProject, want: "GetProject"},
+		{in: "GetProject", want: "GetProject"},
+		{in: "getproject", want: "GetProject"},
+		// AddFileSources shares its ID with AddSources, registered first.
+		{in: pb.NotebookLM_AddFileSources_RPCID, want: "AddSources"},
+		{in: "AddFileSources", want: "AddFileSources"},
+	}
+	for _, tt := range tests {
+		info, ok := rpc.Resolve(tt.in)
+		if !ok {
+			t.Errorf("Resolve(%q) not found", tt.in)
+			continue
+		}
+		if info.Name != tt.want {
+			t.Errorf("Resolve(%q) = %q, want %q", tt.in, info.Name, tt.want)
+		}
+	}
+	if _, ok := rpc.Resolve("nope"); ok {
+		t.Errorf("Resolve(%q) found, want not found", "nope")
+	}
+}
@@ -1,11 +1,15 @@
 package rpc
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/zbigniew-malinowski/nlm/internal/batchexecute"
+	"github.com/zbigniew-malinowski/nlm/internal/beprotojson"
+	"google.golang.org/protobuf/proto"
 )
 
 // RPC endpoint IDs for NotebookLM services
@@ -43,9 +47,17 @@ const (
 	RPCGenerateNotebookGuide  = "VfAZjd" // GenerateNotebookGuide
 	RPCGenerateOutline        = "lCjAd"  // GenerateOutline
 	RPCGenerateSection        = "BeTrYd" // GenerateSection
+	RPCGenerateArtifact       = "ciyUvf" // GenerateArtifact
+	RPCGenerateMindMap        = "Mw3cyf" // GenerateMindMap
+	RPCGenerateFlashcards     = "t8kmZe" // GenerateFlashcards
+	RPCGenerateQuiz           = "Sx0Lrd" // GenerateQuiz
 	RPCStartDraft             = "exXvGf" // StartDraft
 	RPCStartSection           = "pGC7gf" // StartSection
 
+	// NotebookLM service - Chat operations
+	RPCGetChatHistory    = "khqZz"  // GetChatHistory
+	RPCDeleteChatHistory = "J7Gthc" // DeleteChatHistory
+
 	// NotebookLM service - Account operations
 	RPCGetOrCreateAccount = "ZwVcOc" // GetOrCreateAccount
 	RPCMutateAccount      = "hT54vc" // MutateAccount
@@ -69,6 +81,12 @@ const (
 	RPCGuidebookGenerateAnswer      = "itA0pc" // GuidebookGenerateAnswer
 )
 
+// Streaming endpoints. They are called with Stream rather than Do, and the
+// Call ID is the method path instead of an RPC ID.
+const (
+	StreamGenerateFreeForm = "google.internal.labs.tailwind.orchestration.v1.LabsTailwindOrchestrationService/GenerateFreeFormStreamed"
+)
+
 // Call represents a NotebookLM RPC call
 type Call struct {
 	ID         string        // RPC endpoint ID
@@ -76,6 +94,14 @@ type Call struct {
 	NotebookID string        // Optional notebook ID for context
 }
 
+type notebookIDKey struct{}
+
+// WithNotebookID returns a context whose calls are made from the page of a
+// notebook, for requests that do not carry the notebook ID themselves.
+func WithNotebookID(ctx context.Context, notebookID string) context.Context {
+	return context.WithValue(ctx, notebookIDKey{}, notebookID)
+}
+
 // Client handles NotebookLM RPC communication
 type Client struct {
 	Config batchexecute.Config
@@ -114,11 +140,41 @@ func New(authToken, cookies string, options ...batchexecute.Option) *Client {
 	}
 }
 
+// ArgsFromProto encodes m in batchexecute JSON format and returns it as call
+// arguments, one element per field position.
+func ArgsFromProto(m proto.Message) ([]interface{}, error) {
+	b, err := beprotojson.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep numbers as json.Number so they are re-encoded verbatim.
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var args []interface{}
+	if err := dec.Decode(&args); err != nil {
+		return nil, fmt.Errorf("decode args: %w", err)
+	}
+	return args, nil
+}
+
 // Do executes a NotebookLM RPC call
 func (c *Client) Do(call Call) (json.RawMessage, error) {
+	return c.DoContext(context.Background(), call)
+}
+
+// DoContext executes a NotebookLM RPC call with the given context
+func (c *Client) DoContext(ctx context.Context, call Call) (json.RawMessage, error) {
+	if call.NotebookID == "" {
+		call.NotebookID, _ = ctx.Value(notebookIDKey{}).(string)
+	}
 	if c.Config.Debug {
 		fmt.Printf("\n=== RPC Call ===\n")
-		fmt.Printf("ID: %s\n", call.ID)
+		if info, ok := Lookup(call.ID); ok {
+			fmt.Printf("ID: %s (%s.%s)\n", call.ID, info.Service, info.Name)
+		} else {
+			fmt.Printf("ID: %s\n", call.ID)
+		}
 		fmt.Printf("NotebookID: %s\n", call.NotebookID)
 		fmt.Printf("Args:\n")
 		spew.Dump(call.Args)
@@ -148,7 +204,7 @@ func (c *Client) Do(call Call) (json.RawMessage, error) {
 		spew.Dump(rpc)
 	}
 
-	resp, err := c.client.Do(rpc)
+	resp, err := c.client.DoContext(ctx, rpc)
 	if err != nil {
 		return nil, fmt.Errorf("execute rpc: %w", err)
 	}
@@ -161,8 +217,50 @@ func (c *Client) Do(call Call) (json.RawMessage, error) {
 	return resp.Data, nil
 }
 
+// Stream executes a call to a streaming endpoint and calls fn with the
+// payload of every frame as it arrives.
+func (c *Client) Stream(ctx context.Context, call Call, fn func(json.RawMessage) error) error {
+	if c.Config.Debug {
+		fmt.Printf("\n=== Stream Call ===\n")
+		fmt.Printf("Method: %s\n", call.ID)
+		fmt.Printf("NotebookID: %s\n", call.NotebookID)
+		fmt.Printf("Args:\n")
+		spew.Dump(call.Args)
+	}
+
+	urlParams := map[string]string{"source-path": "/"}
+	if call.NotebookID != "" {
+		urlParams["source-path"] = "/notebook/" + call.NotebookID
+	}
+
+	err := c.client.StreamContext(ctx, batchexecute.StreamRequest{
+		Method:    call.ID,
+		Args:      call.Args,
+		URLParams: urlParams,
+	}, fn)
+	if err != nil {
+		return fmt.Errorf("execute stream: %w", err)
+	}
+	return nil
+}
+
 // Heartbeat sends a heartbeat to keep the session alive
 func (c *Client) Heartbeat() error {
+	return c.HeartbeatContext(context.Background())
+}
+
+// HeartbeatContext sends a heartbeat with the given context. The heartbeat
+// is the ListRecentlyViewedProjects call that listing notebooks makes, so
+// it is read-only and fails once the session has expired, which is reported
+// as batchexecute.ErrUnauthorized. Whether it also extends the session, as
+// the web app's own traffic does, is not confirmed.
+func (c *Client) HeartbeatContext(ctx context.Context) error {
+	if _, err := c.DoContext(ctx, Call{
+		ID:   RPCListRecentlyViewedProjects,
+		Args: []interface{}{nil, 1}, // as sent by ListRecentlyViewedProjects
+	}); err != nil {
+		return fmt.Errorf("heartbeat: %w", err)
+	}
 	return nil
 }
 